@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var attemptsCmd = &cobra.Command{
+	Use:   "attempts <job-id>",
+	Short: "List a job's submission attempts",
+	Long:  "jennah attempts <job-id> [--output json]\n\nShows every submission of a job to the batch provider, including automatic\nretries, with the provider job and why each failed attempt failed.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jobID := args[0]
+		outputFmt, _ := cmd.Flags().GetString("output")
+
+		gw, err := newGatewayClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		attempts, err := fetchJobAttempts(gw, jobID)
+		if err != nil {
+			if strings.Contains(err.Error(), "not_found") {
+				return fmt.Errorf("job %q not found", jobID)
+			}
+			return fmt.Errorf("failed to fetch job attempts: %w", err)
+		}
+
+		if outputFmt == "json" {
+			b, _ := json.MarshalIndent(attempts, "", "  ")
+			fmt.Println(string(b))
+			return nil
+		}
+
+		if len(attempts) == 0 {
+			fmt.Println("No attempts recorded.")
+			return nil
+		}
+
+		fmt.Printf("%-3s  %-10s  %-22s  %-19s  %-19s  %-4s  %s\n", "#", "STATUS", "PROVIDER JOB", "STARTED", "ENDED", "EXIT", "FAILURE")
+		fmt.Println(strings.Repeat("─", 106))
+		for _, a := range attempts {
+			failure := "-"
+			if a.FailureClass != "" {
+				failure = a.FailureClass
+				if a.ErrorMessage != "" {
+					failure += ": " + a.ErrorMessage
+				}
+			}
+			providerJob := a.ProviderJobID
+			if providerJob == "" {
+				providerJob = "-"
+			}
+			exitCode := "-"
+			if a.ExitCode != nil {
+				exitCode = fmt.Sprint(*a.ExitCode)
+			}
+			fmt.Printf("%-3d  %-10s  %-22s  %-19s  %-19s  %-4s  %s\n",
+				a.AttemptNumber, a.Status, providerJob, formatTimestamp(a.StartedAt), formatTimestamp(a.EndedAt), exitCode, failure)
+		}
+		return nil
+	},
+}
+
+func init() {
+	attemptsCmd.Flags().String("output", "", "Output format: json")
+}
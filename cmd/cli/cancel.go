@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel <job-id>",
+	Short: "Cancel a job",
+	Long:  "jennah cancel <job-id>\n\nStops a PENDING, SCHEDULED, or RUNNING job and marks it CANCELLED.\nJobs that have already finished cannot be cancelled.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jobID := args[0]
+
+		gw, err := newGatewayClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Cancelling job %s...\n", jobID)
+
+		var result struct {
+			JobID  string `json:"jobId"`
+			Status string `json:"status"`
+		}
+		if err := gw.post("/jennah.v1.DeploymentService/CancelJob", map[string]string{"jobId": jobID}, &result); err != nil {
+			switch {
+			case strings.Contains(err.Error(), "not_found"):
+				return fmt.Errorf("job %s not found", jobID)
+			case strings.Contains(err.Error(), "failed_precondition"):
+				return fmt.Errorf("job %s can no longer be cancelled: %w", jobID, err)
+			}
+			return fmt.Errorf("cancel failed: %w", err)
+		}
+
+		fmt.Println()
+		fmt.Println("✅ Job cancelled successfully!")
+		fmt.Printf("Job ID: %s\n", result.JobID)
+		fmt.Printf("Status: %s\n", result.Status)
+		return nil
+	},
+}
@@ -2,7 +2,10 @@ package main
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -146,3 +149,81 @@ func (c *GatewayClient) post(path string, body interface{}, out interface{}) err
 	}
 	return nil
 }
+
+// Connect streaming envelope flags.
+const (
+	envelopeFlagCompressed = 0x01
+	envelopeFlagEndStream  = 0x02
+)
+
+// stream opens a server-streaming RPC using the Connect protocol with JSON
+// messages and calls onMessage for each message received. It returns when the
+// server ends the stream, onMessage returns an error, or ctx is cancelled.
+func (c *GatewayClient) stream(ctx context.Context, path string, body interface{}, onMessage func(json.RawMessage) error) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	envelope := make([]byte, 5+len(payload))
+	binary.BigEndian.PutUint32(envelope[1:5], uint32(len(payload)))
+	copy(envelope[5:], payload)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+path, bytes.NewReader(envelope))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/connect+json")
+	req.Header.Set("Connect-Protocol-Version", "1")
+	req.Header.Set("X-OAuth-Email", c.email)
+	req.Header.Set("X-OAuth-UserId", c.userID)
+	req.Header.Set("X-OAuth-Provider", c.provider)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("gateway error %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	header := make([]byte, 5)
+	for {
+		if _, err := io.ReadFull(resp.Body, header); err != nil {
+			if errors.Is(err, io.EOF) {
+				return errors.New("stream closed without end-of-stream message")
+			}
+			return fmt.Errorf("stream read failed: %w", err)
+		}
+		flags := header[0]
+		msg := make([]byte, binary.BigEndian.Uint32(header[1:5]))
+		if _, err := io.ReadFull(resp.Body, msg); err != nil {
+			return fmt.Errorf("stream read failed: %w", err)
+		}
+		if flags&envelopeFlagCompressed != 0 {
+			return errors.New("compressed stream messages are not supported")
+		}
+
+		if flags&envelopeFlagEndStream != 0 {
+			var end struct {
+				Error *struct {
+					Code    string `json:"code"`
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(msg, &end); err != nil {
+				return fmt.Errorf("invalid end-of-stream message: %w", err)
+			}
+			if end.Error != nil {
+				return fmt.Errorf("%s: %s", end.Error.Code, end.Error.Message)
+			}
+			return nil
+		}
+
+		if err := onMessage(msg); err != nil {
+			return err
+		}
+	}
+}
@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -11,7 +10,10 @@ import (
 var deleteCmd = &cobra.Command{
 	Use:   "delete <job-id>",
 	Short: "Delete a job",
-	Long:  "jennah delete <job-id> [--all]\n\nPermanently removes a job record from the system.\nUse --all to delete all jobs at once.",
+	Long: "jennah delete <job-id> [--all] [--force]\n\nPermanently removes a finished job and its history.\n" +
+		"Jobs that are still PENDING, SCHEDULED or RUNNING are refused unless --force\n" +
+		"is given, in which case the batch job is cancelled before the record is removed.\n" +
+		"Use --all to delete all jobs at once.",
 	Args: func(cmd *cobra.Command, args []string) error {
 		all, _ := cmd.Flags().GetBool("all")
 		if all {
@@ -21,6 +23,7 @@ var deleteCmd = &cobra.Command{
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		all, _ := cmd.Flags().GetBool("all")
+		force, _ := cmd.Flags().GetBool("force")
 
 		gw, err := newGatewayClient(cmd)
 		if err != nil {
@@ -28,68 +31,95 @@ var deleteCmd = &cobra.Command{
 		}
 
 		if all {
-			return deleteAllJobs(gw)
+			return deleteAllJobs(gw, force)
 		}
 
-		return deleteSingleJob(gw, args[0])
+		return deleteSingleJob(gw, args[0], force)
 	},
 }
 
 func init() {
 	deleteCmd.Flags().Bool("all", false, "Delete all jobs")
+	deleteCmd.Flags().Bool("force", false, "Cancel active jobs before deleting them")
 }
 
-func deleteSingleJob(gw *GatewayClient, jobID string) error {
+// DeleteResult is what the gateway cleaned up for one deleted job.
+type DeleteResult struct {
+	JobID                string `json:"jobId"`
+	Message              string `json:"message"`
+	PreviousStatus       string `json:"previousStatus"`
+	CloudJobCancelled    bool   `json:"cloudJobCancelled"`
+	CloudJobResourcePath string `json:"cloudJobResourcePath"`
+	RecordDeleted        bool   `json:"recordDeleted"`
+}
+
+// deleteJob calls DeleteJob on the gateway.
+func deleteJob(gw *GatewayClient, jobID string, force bool) (*DeleteResult, error) {
+	var result DeleteResult
+	body := map[string]interface{}{"jobId": jobID, "force": force}
+	if err := gw.post("/jennah.v1.DeploymentService/DeleteJob", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func deleteSingleJob(gw *GatewayClient, jobID string, force bool) error {
 	fmt.Printf("Looking up job %s...\n", jobID)
-	jobs, err := fetchJobs(gw)
+	job, err := fetchJob(gw, jobID, false)
 	if err != nil {
-		return fmt.Errorf("failed to fetch jobs: %w", err)
-	}
-	job := findJob(jobs, jobID)
-	if job == nil {
-		return fmt.Errorf("job %s not found", jobID)
+		if strings.Contains(err.Error(), "not_found") {
+			return fmt.Errorf("job %s not found", jobID)
+		}
+		return fmt.Errorf("failed to fetch job: %w", err)
 	}
 
 	fmt.Println("================================")
 	fmt.Printf("  Job ID:   %s\n", job.JobID)
 	fmt.Printf("  Status:   %s\n", job.Status)
-	fmt.Printf("  Image:    %s\n", job.ImageURI)
-	created := job.CreatedAt
-	if t, err := time.Parse(time.RFC3339, job.CreatedAt); err == nil {
-		if loc, err := time.LoadLocation("Asia/Manila"); err == nil {
-			created = t.In(loc).Format("2006-01-02 15:04:05")
-		} else {
-			created = t.Local().Format("2006-01-02 15:04:05")
-		}
-	}
-	fmt.Printf("  Created:  %s\n", created)
+	fmt.Printf("  Image:    %s\n", displayImage(*job))
+	fmt.Printf("  Created:  %s\n", formatTimestamp(job.CreatedAt))
 	fmt.Println("================================")
 	fmt.Println()
-	fmt.Printf("Deleting job %s...\n", jobID)
 
-	var result struct {
-		JobID   string `json:"jobId"`
-		Message string `json:"message"`
+	if !isTerminalStatus(job.Status) && !force {
+		return fmt.Errorf("job %s is %s; cancel it first or re-run with --force", jobID, job.Status)
 	}
-	if err := gw.post("/jennah.v1.DeploymentService/DeleteJob", map[string]string{"jobId": jobID}, &result); err != nil {
-		if strings.Contains(err.Error(), "not_found") {
+	fmt.Printf("Deleting job %s...\n", jobID)
+
+	result, err := deleteJob(gw, jobID, force)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not_found"):
 			return fmt.Errorf("job %s not found", jobID)
-		}
-		jobs2, listErr := fetchJobs(gw)
-		if listErr == nil && findJob(jobs2, jobID) == nil {
-			fmt.Println()
-			fmt.Println("✅ Job deleted successfully!")
-			return nil
+		case strings.Contains(err.Error(), "failed_precondition"):
+			return fmt.Errorf("job %s is still active; re-run with --force to cancel and delete it", jobID)
 		}
 		return fmt.Errorf("delete failed: %w", err)
 	}
 
 	fmt.Println()
 	fmt.Println("✅ Job deleted successfully!")
+	printDeleteResult(result)
 	return nil
 }
 
-func deleteAllJobs(gw *GatewayClient) error {
+// printDeleteResult lists what was cleaned up for a deleted job.
+func printDeleteResult(r *DeleteResult) {
+	fmt.Printf("Previous status:  %s\n", r.PreviousStatus)
+	switch {
+	case r.CloudJobCancelled:
+		fmt.Printf("Cloud job:        cancelled (%s)\n", r.CloudJobResourcePath)
+	case r.CloudJobResourcePath != "":
+		fmt.Printf("Cloud job:        already finished (%s)\n", r.CloudJobResourcePath)
+	default:
+		fmt.Println("Cloud job:        none")
+	}
+	if r.RecordDeleted {
+		fmt.Println("Record:           deleted, including status history")
+	}
+}
+
+func deleteAllJobs(gw *GatewayClient, force bool) error {
 	jobs, err := fetchJobs(gw)
 	if err != nil {
 		return fmt.Errorf("failed to fetch jobs: %w", err)
@@ -103,34 +133,34 @@ func deleteAllJobs(gw *GatewayClient) error {
 	fmt.Println()
 
 	succeeded := 0
+	skipped := 0
 	failed := 0
 	for _, job := range jobs {
 		fmt.Printf("  Deleting %s (%s)... ", job.JobID, job.Status)
-		var result struct {
-			JobID   string `json:"jobId"`
-			Message string `json:"message"`
+		if !isTerminalStatus(job.Status) && !force {
+			fmt.Println("⏭  skipped: still active (use --force)")
+			skipped++
+			continue
 		}
-		err := gw.post("/jennah.v1.DeploymentService/DeleteJob", map[string]string{"jobId": job.JobID}, &result)
+		result, err := deleteJob(gw, job.JobID, force)
 		if err != nil {
-			jobs2, listErr := fetchJobs(gw)
-			if listErr == nil && findJob(jobs2, job.JobID) == nil {
-				fmt.Println("✅")
-				succeeded++
-				continue
-			}
 			fmt.Printf("❌ failed: %v\n", err)
 			failed++
 			continue
 		}
-		fmt.Println("✅")
+		if result.CloudJobCancelled {
+			fmt.Println("✅ (cloud job cancelled)")
+		} else {
+			fmt.Println("✅")
+		}
 		succeeded++
 	}
 
 	fmt.Println()
-	if failed == 0 {
+	if failed == 0 && skipped == 0 {
 		fmt.Printf("✅ All %d job(s) deleted successfully!\n", succeeded)
 	} else {
-		fmt.Printf("Deleted %d job(s), %d failed.\n", succeeded, failed)
+		fmt.Printf("Deleted %d job(s), %d skipped, %d failed.\n", succeeded, skipped, failed)
 	}
 	return nil
 }
@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -10,25 +12,24 @@ import (
 var getCmd = &cobra.Command{
 	Use:   "get [job-id]",
 	Short: "Get job details",
-	Long:  "jennah get <job-id> [--output json]\n\nFetches and displays the details of a specific job by ID.",
+	Long:  "jennah get <job-id> [--output json] [--reveal-env]\n\nFetches and displays the full record of a specific job by ID. Env var values\nare redacted unless --reveal-env is given.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		jobID := args[0]
 		outputFmt, _ := cmd.Flags().GetString("output")
+		revealEnv, _ := cmd.Flags().GetBool("reveal-env")
 
 		gw, err := newGatewayClient(cmd)
 		if err != nil {
 			return err
 		}
 
-		jobs, err := fetchJobs(gw)
+		j, err := fetchJob(gw, jobID, revealEnv)
 		if err != nil {
-			return fmt.Errorf("failed to fetch jobs: %w", err)
-		}
-
-		j := findJob(jobs, jobID)
-		if j == nil {
-			return fmt.Errorf("job %q not found", jobID)
+			if strings.Contains(err.Error(), "not_found") {
+				return fmt.Errorf("job %q not found", jobID)
+			}
+			return fmt.Errorf("failed to fetch job: %w", err)
 		}
 
 		if outputFmt == "json" {
@@ -38,23 +39,175 @@ var getCmd = &cobra.Command{
 
 		fmt.Println("Job Details")
 		fmt.Println("───────────")
-		fmt.Printf("Job ID:   %s\n", j.JobID)
-		fmt.Printf("Status:   %s\n", j.Status)
-		fmt.Printf("Image:    %s\n", j.ImageURI)
-		created := j.CreatedAt
-		if t, err := time.Parse(time.RFC3339, j.CreatedAt); err == nil {
-			if loc, err := time.LoadLocation("Asia/Manila"); err == nil {
-				created = t.In(loc).Format("2006-01-02 15:04:05")
+		fmt.Printf("Job ID:     %s\n", j.JobID)
+		fmt.Printf("Status:     %s\n", j.Status)
+		fmt.Printf("Image:      %s\n", displayImage(*j))
+		if len(j.Commands) > 0 {
+			fmt.Printf("Commands:   %s\n", strings.Join(j.Commands, " "))
+		}
+		fmt.Printf("Tenant:     %s\n", j.TenantID)
+		if len(j.Labels) > 0 {
+			labels := make([]string, 0, len(j.Labels))
+			for k, v := range j.Labels {
+				labels = append(labels, k+"="+v)
+			}
+			sort.Strings(labels)
+			fmt.Printf("Labels:     %s\n", strings.Join(labels, ", "))
+		}
+		if j.ClonedFromJobID != "" {
+			fmt.Printf("Rerun of:   %s\n", j.ClonedFromJobID)
+		}
+		fmt.Println()
+		fmt.Println("Lifecycle")
+		fmt.Println("─────────")
+		fmt.Printf("Created:    %s\n", formatTimestamp(j.CreatedAt))
+		fmt.Printf("Scheduled:  %s\n", formatTimestamp(j.ScheduledAt))
+		fmt.Printf("Started:    %s\n", formatTimestamp(j.StartedAt))
+		fmt.Printf("Completed:  %s\n", formatTimestamp(j.CompletedAt))
+		fmt.Printf("Updated:    %s\n", formatTimestamp(j.UpdatedAt))
+		fmt.Printf("Retries:    %d/%d\n", j.RetryCount-j.PreemptionCount, j.MaxRetries)
+		if j.PreemptionCount > 0 {
+			fmt.Printf("Preempted:  %d times\n", j.PreemptionCount)
+		}
+		if j.NextAttemptAt != "" {
+			fmt.Printf("Next Try:   %s\n", formatTimestamp(j.NextAttemptAt))
+		}
+		if j.ExitCode != nil {
+			fmt.Printf("Exit Code:  %d\n", *j.ExitCode)
+		}
+		if j.FailureCategory != "" {
+			fmt.Printf("Failure:    %s\n", j.FailureCategory)
+		}
+		if j.ErrorMessage != "" {
+			fmt.Printf("Error:      %s\n", j.ErrorMessage)
+		}
+		fmt.Println()
+		fmt.Println("Resources")
+		fmt.Println("─────────")
+		profile := j.ResourceProfile
+		if profile == "" {
+			profile = "default"
+		}
+		fmt.Printf("Profile:    %s\n", profile)
+		if r := j.Resources; r != nil {
+			fmt.Printf("CPU:        %dm\n", r.CPUMillis)
+			fmt.Printf("Memory:     %d MiB\n", r.MemoryMiB)
+			if r.MaxRunDurationSeconds > 0 {
+				fmt.Printf("Timeout:    %s\n", time.Duration(r.MaxRunDurationSeconds)*time.Second)
+			}
+		}
+		if j.CloudJobResourcePath != "" {
+			fmt.Printf("Cloud Job:  %s\n", j.CloudJobResourcePath)
+		}
+		if len(j.ProviderEvents) > 0 {
+			fmt.Println()
+			fmt.Println("Provider Events")
+			fmt.Println("───────────────")
+			for _, e := range j.ProviderEvents {
+				fmt.Printf("%s  %s\n", formatTimestamp(e.EventTime), e.Description)
+			}
+		}
+		if sp := j.Spec; sp != nil {
+			fmt.Println()
+			fmt.Printf("Submitted Spec (v%d)\n", sp.Version)
+			fmt.Println("───────────────────")
+			if sc := sp.Script; sc != nil {
+				interpreter := sc.Interpreter
+				if interpreter == "" {
+					interpreter = "default"
+				}
+				fmt.Printf("Script:     %d lines (interpreter: %s)\n", strings.Count(strings.TrimRight(sc.Text, "\n"), "\n")+1, interpreter)
+			}
+			if sp.TaskCount > 1 {
+				parallelism := "provider default"
+				if sp.Parallelism > 0 {
+					parallelism = fmt.Sprint(sp.Parallelism)
+				}
+				fmt.Printf("Tasks:      %d (parallelism: %s; see jennah tasks)\n", sp.TaskCount, parallelism)
+			}
+			for i, step := range sp.Steps {
+				fmt.Printf("%-12s%s\n", fmt.Sprintf("Step %d:", i+1), step.describe())
+			}
+			for _, v := range sp.Volumes {
+				fmt.Printf("Volume:     %s\n", v.describe())
+			}
+			if sp.Entrypoint != "" {
+				fmt.Printf("Entrypoint: %s\n", sp.Entrypoint)
+			}
+			if o := sp.ContainerOptions; o != nil {
+				if o.WorkingDir != "" {
+					fmt.Printf("Workdir:    %s\n", o.WorkingDir)
+				}
+				if o.User != "" {
+					fmt.Printf("User:       %s\n", o.User)
+				}
+			}
+			if o := sp.ResourceOverride; o != nil {
+				fmt.Printf("Override:   cpu=%dm memory=%dMiB timeout=%ds\n", o.CPUMillis, o.MemoryMiB, o.MaxRunDurationSeconds)
+			}
+			if sp.Priority > 0 {
+				fmt.Printf("Priority:   %d\n", sp.Priority)
+			}
+			if a := sp.Allocation; a != nil {
+				if a.MachineType != "" {
+					fmt.Printf("Machine:    %s\n", a.MachineType)
+				}
+				if a.ProvisioningModel != "" {
+					fmt.Printf("VMs:        %s\n", a.ProvisioningModel)
+				}
+				if len(a.Zones) > 0 {
+					fmt.Printf("Zones:      %s\n", strings.Join(a.Zones, ", "))
+				}
+			}
+			if p := sp.PreemptionPolicy; p != nil {
+				fallback := ""
+				if p.FallbackToStandard {
+					fallback = ", then standard VMs"
+				}
+				fmt.Printf("Preemption: %d resubmissions%s\n", p.MaxRetries, fallback)
+			}
+			if p := sp.RetryPolicy; p != nil {
+				fmt.Printf("Retry:      %d attempts, backoff %ds×%g up to %ds, on %s\n",
+					p.MaxAttempts, p.InitialBackoffSeconds, p.BackoffMultiplier, p.MaxBackoffSeconds, strings.Join(p.RetryOn, ","))
+			}
+			for _, lp := range sp.LifecyclePolicies {
+				codes := make([]string, len(lp.ExitCodes))
+				for i, c := range lp.ExitCodes {
+					codes[i] = fmt.Sprint(c)
+				}
+				fmt.Printf("Lifecycle:  %s on exit %s (max %d task retries)\n", lp.Action, strings.Join(codes, ","), sp.MaxTaskRetries)
+			}
+			if len(sp.EnvVars) == 0 {
+				fmt.Println("Env Vars:   -")
 			} else {
-				created = t.Local().Format("2006-01-02 15:04:05")
+				keys := make([]string, 0, len(sp.EnvVars))
+				for k := range sp.EnvVars {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				fmt.Println("Env Vars:")
+				for _, k := range keys {
+					fmt.Printf("  %s=%s\n", k, sp.EnvVars[k])
+				}
+			}
+			if len(sp.SecretEnvVars) > 0 {
+				keys := make([]string, 0, len(sp.SecretEnvVars))
+				for k := range sp.SecretEnvVars {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				fmt.Println("Secret Env:")
+				for _, k := range keys {
+					s := sp.SecretEnvVars[k]
+					fmt.Printf("  %s from secret %s (version %s)\n", k, s.Secret, s.Version)
+				}
 			}
 		}
-		fmt.Printf("Created:  %s\n", created)
-		fmt.Printf("Tenant:   %s\n", j.TenantID)
 		return nil
 	},
 }
 
 func init() {
 	getCmd.Flags().String("output", "", "Output format: json")
+	getCmd.Flags().Bool("reveal-env", false, "Show env var values instead of redacting them")
 }
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history <job-id>",
+	Short: "Show a job's status timeline",
+	Long:  "jennah history <job-id> [--output json]\n\nShows every status change of a job with its reason and how long the job\nspent in each state.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jobID := args[0]
+		outputFmt, _ := cmd.Flags().GetString("output")
+
+		gw, err := newGatewayClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		h, err := fetchJobHistory(gw, jobID)
+		if err != nil {
+			if strings.Contains(err.Error(), "not_found") {
+				return fmt.Errorf("job %q not found", jobID)
+			}
+			return fmt.Errorf("failed to fetch job history: %w", err)
+		}
+
+		if outputFmt == "json" {
+			b, _ := json.MarshalIndent(h, "", "  ")
+			fmt.Println(string(b))
+			return nil
+		}
+
+		fmt.Printf("Job ID:  %s\n", h.JobID)
+		fmt.Printf("Status:  %s\n", h.CurrentStatus)
+		fmt.Println()
+
+		if len(h.Transitions) == 0 {
+			fmt.Println("No status changes recorded.")
+			return nil
+		}
+
+		durations := stateDurations(h)
+		totals := map[string]time.Duration{}
+		var order []string
+
+		fmt.Printf("%-19s  %-24s  %-14s  %s\n", "TIME", "TRANSITION", "IN STATE", "REASON")
+		fmt.Println(strings.Repeat("─", 90))
+		for i, t := range h.Transitions {
+			transition := t.ToStatus
+			if t.FromStatus != "" {
+				transition = t.FromStatus + " → " + t.ToStatus
+			}
+
+			inState := "-"
+			if d, ok := durations[i]; ok {
+				inState = formatDuration(d)
+				if i == len(h.Transitions)-1 {
+					inState += " (now)"
+				}
+				if _, seen := totals[t.ToStatus]; !seen {
+					order = append(order, t.ToStatus)
+				}
+				totals[t.ToStatus] += d
+			}
+
+			fmt.Printf("%-19s  %-24s  %-14s  %s\n", formatTimestamp(t.TransitionedAt), transition, inState, t.Reason)
+		}
+
+		if len(order) > 0 {
+			fmt.Println()
+			fmt.Println("Time in State")
+			fmt.Println("─────────────")
+			for _, status := range order {
+				fmt.Printf("%-10s  %s\n", status+":", formatDuration(totals[status]))
+			}
+		}
+		return nil
+	},
+}
+
+// stateDurations returns, for each transition index, how long the job stayed
+// in the status it entered. The last entry runs until now unless the job has
+// finished, in which case it has no duration.
+func stateDurations(h *JobHistory) map[int]time.Duration {
+	durations := map[int]time.Duration{}
+	for i, t := range h.Transitions {
+		start, err := time.Parse(time.RFC3339Nano, t.TransitionedAt)
+		if err != nil {
+			continue
+		}
+
+		var end time.Time
+		if i+1 < len(h.Transitions) {
+			end, err = time.Parse(time.RFC3339Nano, h.Transitions[i+1].TransitionedAt)
+			if err != nil {
+				continue
+			}
+		} else if !isTerminalStatus(t.ToStatus) {
+			end = time.Now()
+		} else {
+			continue
+		}
+		durations[i] = end.Sub(start)
+	}
+	return durations
+}
+
+// formatDuration renders d rounded to a readable precision.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d < time.Second:
+		return d.Round(time.Millisecond).String()
+	case d < time.Hour:
+		return d.Round(time.Second).String()
+	default:
+		return d.Round(time.Minute).String()
+	}
+}
+
+func init() {
+	historyCmd.Flags().String("output", "", "Output format: json")
+}
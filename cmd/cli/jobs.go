@@ -3,36 +3,297 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 )
 
 // Job is the common job structure returned by the gateway.
 type Job struct {
-	JobID     string `json:"jobId"`
-	TenantID  string `json:"tenantId"`
-	ImageURI  string `json:"imageUri"`
-	Status    string `json:"status"`
-	CreatedAt string `json:"createdAt"`
+	JobID                string            `json:"jobId"`
+	TenantID             string            `json:"tenantId"`
+	ImageURI             string            `json:"imageUri"`
+	Status               string            `json:"status"`
+	CreatedAt            string            `json:"createdAt"`
+	UpdatedAt            string            `json:"updatedAt,omitempty"`
+	ScheduledAt          string            `json:"scheduledAt,omitempty"`
+	StartedAt            string            `json:"startedAt,omitempty"`
+	CompletedAt          string            `json:"completedAt,omitempty"`
+	RetryCount           int64             `json:"retryCount,omitempty,string"`
+	MaxRetries           int64             `json:"maxRetries,omitempty,string"`
+	PreemptionCount      int64             `json:"preemptionCount,omitempty,string"`
+	ErrorMessage         string            `json:"errorMessage,omitempty"`
+	CloudJobResourcePath string            `json:"cloudJobResourcePath,omitempty"`
+	Commands             []string          `json:"commands,omitempty"`
+	ResourceProfile      string            `json:"resourceProfile,omitempty"`
+	Resources            *JobResources     `json:"resources,omitempty"`
+	Spec                 *JobSpec          `json:"spec,omitempty"`
+	ClonedFromJobID      string            `json:"clonedFromJobId,omitempty"`
+	NextAttemptAt        string            `json:"nextAttemptAt,omitempty"`
+	ExitCode             *int32            `json:"exitCode,omitempty"`
+	FailureCategory      string            `json:"failureCategory,omitempty"`
+	ProviderEvents       []ProviderEvent   `json:"providerEvents,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty"`
 }
 
-// fetchJobs calls ListJobs on the gateway and returns all jobs for the user.
-func fetchJobs(gw *GatewayClient) ([]Job, error) {
+// ProviderEvent is one status event reported by the batch provider.
+type ProviderEvent struct {
+	EventTime   string `json:"eventTime"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description"`
+}
+
+// JobSpec is the normalized submission stored with a job.
+type JobSpec struct {
+	Version           int               `json:"version"`
+	ImageURI          string            `json:"imageUri"`
+	Commands          []string          `json:"commands,omitempty"`
+	Entrypoint        string            `json:"entrypoint,omitempty"`
+	ContainerOptions  *ContainerOptions `json:"containerOptions,omitempty"`
+	Script            *Script           `json:"script,omitempty"`
+	Steps             []Step            `json:"steps,omitempty"`
+	TaskCount         int64             `json:"taskCount,omitempty,string"`
+	Parallelism       int64             `json:"parallelism,omitempty,string"`
+	Volumes           []Volume          `json:"volumes,omitempty"`
+	Priority          int64             `json:"priority,omitempty,string"`
+	Allocation        *Allocation       `json:"allocation,omitempty"`
+	PreemptionPolicy  *PreemptionPolicy `json:"preemptionPolicy,omitempty"`
+	EnvVars           map[string]string `json:"envVars,omitempty"`
+	ResourceProfile   string            `json:"resourceProfile,omitempty"`
+	ResourceOverride  *JobResources     `json:"resourceOverride,omitempty"`
+	Resources         *JobResources     `json:"resources,omitempty"`
+	RetryPolicy       *RetryPolicy      `json:"retryPolicy,omitempty"`
+	LifecyclePolicies []LifecyclePolicy `json:"lifecyclePolicies,omitempty"`
+	MaxTaskRetries    int32             `json:"maxTaskRetries,omitempty"`
+	// SecretEnvVars hold references only; secret values never leave the provider.
+	SecretEnvVars map[string]SecretEnvVar `json:"secretEnvVars,omitempty"`
+}
+
+// Step is one runnable of a multi-step job.
+type Step struct {
+	Name             string            `json:"name,omitempty"`
+	ImageURI         string            `json:"imageUri,omitempty"`
+	Commands         []string          `json:"commands,omitempty"`
+	Entrypoint       string            `json:"entrypoint,omitempty"`
+	ContainerOptions *ContainerOptions `json:"containerOptions,omitempty"`
+	Script           *Script           `json:"script,omitempty"`
+	Barrier          bool              `json:"barrier,omitempty"`
+	EnvVars          map[string]string `json:"envVars,omitempty"`
+	Background       bool              `json:"background,omitempty"`
+	IgnoreExitStatus bool              `json:"ignoreExitStatus,omitempty"`
+}
+
+// describe summarizes a step on one line, e.g. "setup: ubuntu:22.04 (background)".
+func (s Step) describe() string {
+	var what string
+	switch {
+	case s.Barrier:
+		what = "barrier"
+	case s.Script != nil:
+		what = "script"
+	default:
+		what = s.ImageURI
+		if len(s.Commands) > 0 {
+			what += " " + strings.Join(s.Commands, " ")
+		}
+	}
+	if s.Name != "" {
+		what = s.Name + ": " + what
+	}
+	var flags []string
+	if s.Background {
+		flags = append(flags, "background")
+	}
+	if s.IgnoreExitStatus {
+		flags = append(flags, "ignore exit status")
+	}
+	if len(flags) > 0 {
+		what += " (" + strings.Join(flags, ", ") + ")"
+	}
+	return what
+}
+
+// Allocation chooses the VMs a job runs on.
+type Allocation struct {
+	MachineType       string   `json:"machineType,omitempty"`
+	ProvisioningModel string   `json:"provisioningModel,omitempty"`
+	Zones             []string `json:"zones,omitempty"`
+}
+
+// PreemptionPolicy limits resubmissions after spot VMs are reclaimed.
+type PreemptionPolicy struct {
+	MaxRetries         int64 `json:"maxRetries,omitempty,string"`
+	FallbackToStandard bool  `json:"fallbackToStandard,omitempty"`
+}
+
+// SecretEnvVar references the secret an environment variable is read from.
+type SecretEnvVar struct {
+	Secret  string `json:"secret"`
+	Version string `json:"version,omitempty"`
+}
+
+// Volume is storage mounted into a job's runnables.
+type Volume struct {
+	MountPath string      `json:"mountPath"`
+	ReadOnly  bool        `json:"readOnly,omitempty"`
+	GCS       *GCSVolume  `json:"gcs,omitempty"`
+	NFS       *NFSVolume  `json:"nfs,omitempty"`
+	Disk      *DiskVolume `json:"disk,omitempty"`
+}
+
+// GCSVolume is a Cloud Storage bucket, or a folder within one.
+type GCSVolume struct {
+	Bucket string `json:"bucket"`
+	Path   string `json:"path,omitempty"`
+}
+
+// NFSVolume is a directory exported by an NFS server.
+type NFSVolume struct {
+	Server     string `json:"server"`
+	RemotePath string `json:"remotePath"`
+}
+
+// DiskVolume is an empty disk created for each task.
+type DiskVolume struct {
+	SizeGB int64  `json:"sizeGb,omitempty,string"`
+	Type   string `json:"type,omitempty"`
+}
+
+// describe summarizes a volume on one line, e.g. "/mnt/in <- gs://bucket/in (read-only)".
+func (v Volume) describe() string {
+	var source string
+	switch {
+	case v.GCS != nil:
+		source = "gs://" + v.GCS.Bucket
+		if v.GCS.Path != "" {
+			source += "/" + v.GCS.Path
+		}
+	case v.NFS != nil:
+		source = "nfs://" + v.NFS.Server + v.NFS.RemotePath
+	case v.Disk != nil:
+		diskType := v.Disk.Type
+		if diskType == "" {
+			diskType = "pd-balanced"
+		}
+		source = fmt.Sprintf("%d GB %s disk", v.Disk.SizeGB, diskType)
+	}
+	what := v.MountPath + " <- " + source
+	if v.ReadOnly {
+		what += " (read-only)"
+	}
+	return what
+}
+
+// Script is an inline runnable used instead of a container image.
+type Script struct {
+	Text        string `json:"text"`
+	Interpreter string `json:"interpreter,omitempty"`
+}
+
+// ContainerOptions change how a job's container is run.
+type ContainerOptions struct {
+	WorkingDir string `json:"workingDir,omitempty"`
+	User       string `json:"user,omitempty"`
+}
+
+// LifecyclePolicy maps container exit codes to a batch provider action.
+type LifecyclePolicy struct {
+	Action    string  `json:"action"`
+	ExitCodes []int32 `json:"exitCodes"`
+}
+
+// RetryPolicy controls automatic resubmission of a failed job.
+type RetryPolicy struct {
+	MaxAttempts           int64    `json:"maxAttempts,omitempty,string"`
+	InitialBackoffSeconds int64    `json:"initialBackoffSeconds,omitempty,string"`
+	MaxBackoffSeconds     int64    `json:"maxBackoffSeconds,omitempty,string"`
+	BackoffMultiplier     float64  `json:"backoffMultiplier,omitempty"`
+	RetryOn               []string `json:"retryOn,omitempty"`
+}
+
+// JobAttempt is one submission of a job to the batch provider.
+type JobAttempt struct {
+	AttemptNumber        int64           `json:"attemptNumber,omitempty,string"`
+	Status               string          `json:"status"`
+	ProviderJobID        string          `json:"providerJobId,omitempty"`
+	CloudJobResourcePath string          `json:"cloudJobResourcePath,omitempty"`
+	FailureClass         string          `json:"failureClass,omitempty"`
+	ErrorMessage         string          `json:"errorMessage,omitempty"`
+	StartedAt            string          `json:"startedAt,omitempty"`
+	EndedAt              string          `json:"endedAt,omitempty"`
+	ExitCode             *int32          `json:"exitCode,omitempty"`
+	FailureCategory      string          `json:"failureCategory,omitempty"`
+	ProviderEvents       []ProviderEvent `json:"providerEvents,omitempty"`
+}
+
+// JobResources are the compute resources a job was submitted with.
+// The gateway encodes int64 values as JSON strings.
+type JobResources struct {
+	CPUMillis             int64 `json:"cpuMillis,omitempty,string"`
+	MemoryMiB             int64 `json:"memoryMib,omitempty,string"`
+	MaxRunDurationSeconds int64 `json:"maxRunDurationSeconds,omitempty,string"`
+}
+
+// JobQuery holds the ListJobs filters and paging options.
+type JobQuery struct {
+	PageSize       int      `json:"pageSize,omitempty"`
+	PageToken      string   `json:"pageToken,omitempty"`
+	Statuses       []string `json:"statuses,omitempty"`
+	CreatedAfter   string   `json:"createdAfter,omitempty"`
+	CreatedBefore  string   `json:"createdBefore,omitempty"`
+	ImageURIPrefix string   `json:"imageUriPrefix,omitempty"`
+	LabelSelectors []string `json:"labelSelectors,omitempty"`
+}
+
+// fetchJobsPage calls ListJobs once and returns the page plus the next page token.
+func fetchJobsPage(gw *GatewayClient, q JobQuery) ([]Job, string, error) {
 	var result struct {
-		Jobs []Job `json:"jobs"`
+		Jobs          []Job  `json:"jobs"`
+		NextPageToken string `json:"nextPageToken"`
 	}
-	if err := gw.post("/jennah.v1.DeploymentService/ListJobs", map[string]interface{}{}, &result); err != nil {
-		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	if err := gw.post("/jennah.v1.DeploymentService/ListJobs", q, &result); err != nil {
+		return nil, "", fmt.Errorf("failed to list jobs: %w", err)
 	}
-	return result.Jobs, nil
+	return result.Jobs, result.NextPageToken, nil
 }
 
-// findJob returns the job with the given ID from a list, or nil.
-func findJob(jobs []Job, jobID string) *Job {
-	for i := range jobs {
-		if jobs[i].JobID == jobID {
-			return &jobs[i]
+// fetchAllJobs follows ListJobs pages until every job matching q is loaded.
+func fetchAllJobs(gw *GatewayClient, q JobQuery) ([]Job, error) {
+	if q.PageSize == 0 {
+		q.PageSize = 1000
+	}
+	var all []Job
+	for {
+		jobs, next, err := fetchJobsPage(gw, q)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, jobs...)
+		if next == "" {
+			return all, nil
 		}
+		q.PageToken = next
 	}
-	return nil
+}
+
+// fetchJobs returns all jobs for the user.
+func fetchJobs(gw *GatewayClient) ([]Job, error) {
+	return fetchAllJobs(gw, JobQuery{})
+}
+
+// fetchJob calls GetJob on the gateway and returns the full job record. Env
+// var values are redacted unless revealEnvVars is set.
+func fetchJob(gw *GatewayClient, jobID string, revealEnvVars bool) (*Job, error) {
+	var result struct {
+		Job *Job `json:"job"`
+	}
+	req := map[string]interface{}{"jobId": jobID, "revealEnvVars": revealEnvVars}
+	if err := gw.post("/jennah.v1.DeploymentService/GetJob", req, &result); err != nil {
+		return nil, err
+	}
+	if result.Job == nil {
+		return nil, fmt.Errorf("job %s not found", jobID)
+	}
+	return result.Job, nil
 }
 
 // printJobsJSON prints jobs as a JSON array.
@@ -40,3 +301,73 @@ func printJobsJSON(jobs []Job) {
 	b, _ := json.MarshalIndent(jobs, "", "  ")
 	fmt.Println(string(b))
 }
+
+// formatTimestamp renders an RFC 3339 timestamp in local display time.
+// Empty values are shown as "-".
+func formatTimestamp(ts string) string {
+	if ts == "" {
+		return "-"
+	}
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return ts
+	}
+	if loc, err := time.LoadLocation("Asia/Manila"); err == nil {
+		return t.In(loc).Format("2006-01-02 15:04:05")
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
+
+// JobTransition is one entry of a job's status timeline.
+type JobTransition struct {
+	TransitionID   string `json:"transitionId"`
+	FromStatus     string `json:"fromStatus,omitempty"`
+	ToStatus       string `json:"toStatus"`
+	TransitionedAt string `json:"transitionedAt"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+// JobHistory is a job's current status and its timeline, oldest first.
+type JobHistory struct {
+	JobID         string          `json:"jobId"`
+	CurrentStatus string          `json:"currentStatus"`
+	Transitions   []JobTransition `json:"transitions"`
+}
+
+// fetchJobHistory calls GetJobHistory on the gateway.
+func fetchJobHistory(gw *GatewayClient, jobID string) (*JobHistory, error) {
+	var result JobHistory
+	if err := gw.post("/jennah.v1.DeploymentService/GetJobHistory", map[string]string{"jobId": jobID}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func fetchJobAttempts(gw *GatewayClient, jobID string) ([]JobAttempt, error) {
+	var result struct {
+		Attempts []JobAttempt `json:"attempts"`
+	}
+	if err := gw.post("/jennah.v1.DeploymentService/ListJobAttempts", map[string]string{"jobId": jobID}, &result); err != nil {
+		return nil, err
+	}
+	return result.Attempts, nil
+}
+
+// displayImage returns the job's image, or "(script)" for script jobs. For
+// multi-step jobs the gateway reports the first container step's image.
+func displayImage(j Job) string {
+	if j.ImageURI == "" {
+		return "(script)"
+	}
+	return j.ImageURI
+}
+
+// isTerminalStatus reports whether a job in the given status will not change again.
+func isTerminalStatus(status string) bool {
+	switch status {
+	case "COMPLETED", "FAILED", "CANCELLED":
+		return true
+	default:
+		return false
+	}
+}
@@ -10,15 +10,51 @@ import (
 
 var listCmd = &cobra.Command{
 	Use:   "list",
-	Short: "List all your jobs",
-	Long:  "jennah list\n\nDisplays all jobs submitted under your account.",
+	Short: "List your jobs",
+	Long: "jennah list [--status S] [--created-after T] [--created-before T] [--image-prefix P]\n" +
+		"            [-l KEY=VALUE]... [--page-size N] [--page-token TOKEN]\n\n" +
+		"Displays jobs submitted under your account, newest first.\n" +
+		"-l keeps jobs that have every given label.\n" +
+		"Times are RFC 3339 (2026-02-10T00:00:00Z) or dates (2026-02-10, UTC).\n" +
+		"With --page-size or --page-token only one page is shown.",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		statuses, _ := cmd.Flags().GetStringSlice("status")
+		createdAfter, _ := cmd.Flags().GetString("created-after")
+		createdBefore, _ := cmd.Flags().GetString("created-before")
+		imagePrefix, _ := cmd.Flags().GetString("image-prefix")
+		labelSelectors, _ := cmd.Flags().GetStringSlice("label")
+		pageSize, _ := cmd.Flags().GetInt("page-size")
+		pageToken, _ := cmd.Flags().GetString("page-token")
+
+		q := JobQuery{
+			PageSize:       pageSize,
+			PageToken:      pageToken,
+			ImageURIPrefix: imagePrefix,
+			LabelSelectors: labelSelectors,
+		}
+		for _, s := range statuses {
+			q.Statuses = append(q.Statuses, strings.ToUpper(s))
+		}
+		var err error
+		if q.CreatedAfter, err = parseTimeFlag(createdAfter); err != nil {
+			return fmt.Errorf("invalid --created-after: %w", err)
+		}
+		if q.CreatedBefore, err = parseTimeFlag(createdBefore); err != nil {
+			return fmt.Errorf("invalid --created-before: %w", err)
+		}
+
 		gw, err := newGatewayClient(cmd)
 		if err != nil {
 			return err
 		}
 
-		jobs, err := fetchJobs(gw)
+		var jobs []Job
+		nextToken := ""
+		if cmd.Flags().Changed("page-size") || pageToken != "" {
+			jobs, nextToken, err = fetchJobsPage(gw, q)
+		} else {
+			jobs, err = fetchAllJobs(gw, q)
+		}
 		if err != nil {
 			return err
 		}
@@ -31,7 +67,7 @@ var listCmd = &cobra.Command{
 		fmt.Printf("%-38s  %-12s  %-45s  %s\n", "JOB ID", "STATUS", "IMAGE", "CREATED")
 		fmt.Println(strings.Repeat("─", 110))
 		for _, j := range jobs {
-			img := j.ImageURI
+			img := displayImage(j)
 			if len(img) > 43 {
 				img = "..." + img[len(img)-40:]
 			}
@@ -45,6 +81,36 @@ var listCmd = &cobra.Command{
 			}
 			fmt.Printf("%-38s  %-12s  %-45s  %s\n", j.JobID, j.Status, img, created)
 		}
+		if nextToken != "" {
+			fmt.Println()
+			fmt.Printf("More jobs available. Next page: --page-token %s\n", nextToken)
+		}
 		return nil
 	},
 }
+
+// parseTimeFlag accepts an RFC 3339 time or a YYYY-MM-DD date (UTC midnight)
+// and returns it in RFC 3339 form. Empty input stays empty.
+func parseTimeFlag(v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.Format(time.RFC3339), nil
+	}
+	t, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		return "", fmt.Errorf("%q is not an RFC 3339 time or YYYY-MM-DD date", v)
+	}
+	return t.Format(time.RFC3339), nil
+}
+
+func init() {
+	listCmd.Flags().StringSlice("status", nil, "Only show jobs in these statuses (repeatable or comma-separated)")
+	listCmd.Flags().String("created-after", "", "Only show jobs created at or after this time")
+	listCmd.Flags().String("created-before", "", "Only show jobs created before this time")
+	listCmd.Flags().String("image-prefix", "", "Only show jobs whose image URI starts with this prefix")
+	listCmd.Flags().StringSliceP("label", "l", nil, "Only show jobs with this label (KEY=VALUE, repeatable or comma-separated)")
+	listCmd.Flags().Int("page-size", 0, "Show a single page of at most this many jobs")
+	listCmd.Flags().String("page-token", "", "Show the page after a previous --page-size listing")
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs <job-id>",
+	Short: "Show a job's output logs",
+	Long: "jennah logs <job-id> [--follow] [--since 10m] [--attempt N] [--output json]\n\n" +
+		"Prints the output of a job's latest attempt (or --attempt N). --since takes\n" +
+		"a duration ago (10m, 2h), an RFC 3339 time or a YYYY-MM-DD date. With\n" +
+		"--follow, keeps printing new lines until the job finishes.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jobID := args[0]
+		follow, _ := cmd.Flags().GetBool("follow")
+		sinceFlag, _ := cmd.Flags().GetString("since")
+		attempt, _ := cmd.Flags().GetInt64("attempt")
+		outputFmt, _ := cmd.Flags().GetString("output")
+
+		since, err := parseSinceFlag(sinceFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --since: %w", err)
+		}
+
+		gw, err := newGatewayClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		onEntry := func(e LogEntry) {
+			if outputFmt == "json" {
+				b, _ := json.Marshal(e)
+				fmt.Println(string(b))
+				return
+			}
+			printLogEntry(e)
+		}
+
+		if follow {
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			err = followJobLogs(ctx, gw, jobID, attempt, since, onEntry)
+		} else {
+			err = fetchJobLogs(gw, jobID, attempt, since, onEntry)
+		}
+		if err != nil {
+			if strings.Contains(err.Error(), "not_found") {
+				return fmt.Errorf("job %q not found", jobID)
+			}
+			return fmt.Errorf("failed to fetch job logs: %w", err)
+		}
+		return nil
+	},
+}
+
+// LogEntry is one line of job output.
+type LogEntry struct {
+	Timestamp string `json:"timestamp"`
+	Severity  string `json:"severity,omitempty"`
+	Message   string `json:"message"`
+}
+
+type jobLogsRequest struct {
+	JobID         string `json:"jobId"`
+	AttemptNumber int64  `json:"attemptNumber,omitempty,string"`
+	Since         string `json:"since,omitempty"`
+	PageToken     string `json:"pageToken,omitempty"`
+}
+
+// fetchJobLogs prints every page of a job's logs.
+func fetchJobLogs(gw *GatewayClient, jobID string, attempt int64, since string, onEntry func(LogEntry)) error {
+	req := jobLogsRequest{JobID: jobID, AttemptNumber: attempt, Since: since}
+	for {
+		var page struct {
+			Entries       []LogEntry `json:"entries"`
+			NextPageToken string     `json:"nextPageToken"`
+		}
+		if err := gw.post("/jennah.v1.DeploymentService/GetJobLogs", req, &page); err != nil {
+			return err
+		}
+		for _, e := range page.Entries {
+			onEntry(e)
+		}
+		if page.NextPageToken == "" {
+			return nil
+		}
+		req.PageToken = page.NextPageToken
+	}
+}
+
+// followJobLogs streams a job's logs until the gateway ends the stream after
+// the job finishes, reconnecting from the last entry after connection errors.
+func followJobLogs(ctx context.Context, gw *GatewayClient, jobID string, attempt int64, since string, onEntry func(LogEntry)) error {
+	// Entries already printed at the newest timestamp, so a reconnect that
+	// re-reads from that timestamp does not print them twice.
+	printed := make(map[string]bool)
+	backoff := time.Second
+	for {
+		req := jobLogsRequest{JobID: jobID, AttemptNumber: attempt, Since: since}
+		err := gw.stream(ctx, "/jennah.v1.DeploymentService/StreamJobLogs", req, func(raw json.RawMessage) error {
+			var msg struct {
+				Entries []LogEntry `json:"entries"`
+			}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				return fmt.Errorf("invalid log message: %w", err)
+			}
+			backoff = time.Second
+			for _, e := range msg.Entries {
+				if e.Timestamp == since && printed[e.Message] {
+					continue
+				}
+				if e.Timestamp != since {
+					since = e.Timestamp
+					clear(printed)
+				}
+				printed[e.Message] = true
+				onEntry(e)
+			}
+			return nil
+		})
+		if ctx.Err() != nil || err == nil {
+			return nil
+		}
+		if !isRetryableStreamError(err) {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "  stream interrupted (%v), reconnecting in %s...\n", err, backoff)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, 30*time.Second)
+	}
+}
+
+// printLogEntry prints one log line, marking lines above INFO with their severity.
+func printLogEntry(e LogEntry) {
+	switch e.Severity {
+	case "", "DEFAULT", "DEBUG", "INFO", "NOTICE":
+		fmt.Printf("[%s]  %s\n", formatClock(e.Timestamp), e.Message)
+	default:
+		fmt.Printf("[%s]  %s: %s\n", formatClock(e.Timestamp), e.Severity, e.Message)
+	}
+}
+
+// parseSinceFlag accepts a duration ago (e.g. 10m) in addition to the times
+// parseTimeFlag understands.
+func parseSinceFlag(v string) (string, error) {
+	if d, err := time.ParseDuration(v); err == nil {
+		if d < 0 {
+			return "", fmt.Errorf("%q must not be negative", v)
+		}
+		return time.Now().Add(-d).UTC().Format(time.RFC3339), nil
+	}
+	return parseTimeFlag(v)
+}
+
+func init() {
+	logsCmd.Flags().BoolP("follow", "f", false, "Keep printing new lines until the job finishes")
+	logsCmd.Flags().String("since", "", "Only show lines from this long ago (e.g. 10m) or since this time")
+	logsCmd.Flags().Int64("attempt", 0, "Attempt to show (default: latest)")
+	logsCmd.Flags().String("output", "", "Output format: json (one entry per line)")
+}
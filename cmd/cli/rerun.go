@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var rerunCmd = &cobra.Command{
+	Use:   "rerun <job-id>",
+	Short: "Rerun a previous job",
+	Long: "jennah rerun <job-id> [--env K=V]... [--label K=V]... [--profile P] [--wait]\n\n" +
+		"Submits a new job with the same image, env vars, labels and resources as an\n" +
+		"existing job. --env and --label add or replace env vars and labels; --profile\n" +
+		"switches the resource preset and drops any resource override the original job had.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jobID := args[0]
+		envFlags, _ := cmd.Flags().GetStringArray("env")
+		labelFlags, _ := cmd.Flags().GetStringArray("label")
+		profile, _ := cmd.Flags().GetString("profile")
+		wait, _ := cmd.Flags().GetBool("wait")
+
+		envVars := map[string]string{}
+		for _, kv := range envFlags {
+			k, v, ok := strings.Cut(kv, "=")
+			if !ok || k == "" {
+				return fmt.Errorf("invalid --env %q: expected KEY=VALUE", kv)
+			}
+			envVars[k] = v
+		}
+		labels := map[string]string{}
+		for _, kv := range labelFlags {
+			k, v, ok := strings.Cut(kv, "=")
+			if !ok || k == "" {
+				return fmt.Errorf("invalid --label %q: expected KEY=VALUE", kv)
+			}
+			labels[k] = v
+		}
+
+		gw, err := newGatewayClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		body := map[string]interface{}{"jobId": jobID}
+		if len(envVars) > 0 {
+			body["envVars"] = envVars
+		}
+		if len(labels) > 0 {
+			body["labels"] = labels
+		}
+		if profile != "" {
+			body["resourceProfile"] = profile
+		}
+
+		fmt.Printf("Rerunning job %s...\n", jobID)
+
+		var result struct {
+			JobID           string `json:"jobId"`
+			Status          string `json:"status"`
+			WorkerAssigned  string `json:"workerAssigned"`
+			ClonedFromJobID string `json:"clonedFromJobId"`
+		}
+		if err := gw.post("/jennah.v1.DeploymentService/RerunJob", body, &result); err != nil {
+			switch {
+			case strings.Contains(err.Error(), "not_found"):
+				return fmt.Errorf("job %s not found", jobID)
+			case strings.Contains(err.Error(), "failed_precondition"):
+				return fmt.Errorf("job %s cannot be rerun: %w", jobID, err)
+			}
+			return fmt.Errorf("rerun failed: %w", err)
+		}
+
+		fmt.Println()
+		fmt.Println("✅ Job resubmitted successfully!")
+		fmt.Printf("Job ID:      %s\n", result.JobID)
+		fmt.Printf("Rerun of:    %s\n", result.ClonedFromJobID)
+		fmt.Printf("Status:      %s\n", result.Status)
+
+		if !wait {
+			return nil
+		}
+		return waitForJob(gw, result.JobID)
+	},
+}
+
+func init() {
+	rerunCmd.Flags().StringArray("env", nil, "Add or replace an env var (KEY=VALUE, repeatable)")
+	rerunCmd.Flags().StringArray("label", nil, "Add or replace a label (KEY=VALUE, repeatable)")
+	rerunCmd.Flags().String("profile", "", "Use a different resource profile")
+	rerunCmd.Flags().Bool("wait", false, "Stream status changes until the new job completes")
+}
@@ -27,8 +27,15 @@ func init() {
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 
 	rootCmd.AddCommand(submitCmd)
+	rootCmd.AddCommand(rerunCmd)
 	rootCmd.AddCommand(getCmd)
+	rootCmd.AddCommand(historyCmd)
+	rootCmd.AddCommand(attemptsCmd)
+	rootCmd.AddCommand(logsCmd)
+	rootCmd.AddCommand(tasksCmd)
 	rootCmd.AddCommand(listCmd)
+	rootCmd.AddCommand(watchCmd)
+	rootCmd.AddCommand(cancelCmd)
 	rootCmd.AddCommand(deleteCmd)
 	rootCmd.AddCommand(tenantCmd)
 	rootCmd.AddCommand(loginCmd)
@@ -1,32 +1,63 @@
 package main
 
 import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/signal"
-	"syscall"
-	"time"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
 
 var submitCmd = &cobra.Command{
-	Use:   "submit <job.json>",
+	Use:   "submit [job.json]",
 	Short: "Submit a job",
-	Long:  "jennah submit <job.json> [--wait]\n\nReads job parameters from a JSON file and submits the job.\nUse --wait to stream status changes until the job completes.",
-	Args:  cobra.ExactArgs(1),
+	Long: "jennah submit <job.json> [--wait]\n" +
+		"jennah submit --script <task.sh> [--interpreter /bin/bash] [job.json] [--wait]\n\n" +
+		"Reads job parameters from a JSON file and submits the job. With --script,\n" +
+		"runs the file's text instead of a container image; job.json is then\n" +
+		"optional and must not set image_uri.\n" +
+		"Use --wait to stream status changes until the job completes.\n\n" +
+		"Every submission carries a request ID (generated unless --request-id is\n" +
+		"given). Retrying with the same --request-id after a timeout returns the\n" +
+		"original job instead of creating another one.",
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		wait, _ := cmd.Flags().GetBool("wait")
+		scriptPath, _ := cmd.Flags().GetString("script")
+		interpreter, _ := cmd.Flags().GetString("interpreter")
+		requestID, _ := cmd.Flags().GetString("request-id")
 
-		data, err := os.ReadFile(args[0])
-		if err != nil {
-			return fmt.Errorf("failed to read %s: %w", args[0], err)
+		if len(args) == 0 && scriptPath == "" {
+			return fmt.Errorf("a job.json file or --script is required")
+		}
+		if interpreter != "" && scriptPath == "" {
+			return fmt.Errorf("--interpreter requires --script")
 		}
 
-		var body map[string]interface{}
-		if err := json.Unmarshal(data, &body); err != nil {
-			return fmt.Errorf("invalid JSON in %s: %w", args[0], err)
+		body := map[string]interface{}{}
+		if len(args) == 1 {
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", args[0], err)
+			}
+			if err := json.Unmarshal(data, &body); err != nil {
+				return fmt.Errorf("invalid JSON in %s: %w", args[0], err)
+			}
+		}
+		if scriptPath != "" {
+			text, err := os.ReadFile(scriptPath)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", scriptPath, err)
+			}
+			script := map[string]interface{}{"text": string(text)}
+			if interpreter != "" {
+				script["interpreter"] = interpreter
+			}
+			body["script"] = script
 		}
 
 		gw, err := newGatewayClient(cmd)
@@ -62,20 +93,47 @@ var submitCmd = &cobra.Command{
 				delete(body, "env_vars")
 			}
 		}
+		for snake, camel := range map[string]string{
+			"retry_policy":       "retryPolicy",
+			"lifecycle_policies": "lifecyclePolicies",
+			"max_task_retries":   "maxTaskRetries",
+			"container_options":  "containerOptions",
+			"task_count":         "taskCount",
+			"preemption_policy":  "preemptionPolicy",
+			"secret_env_vars":    "secretEnvVars",
+			"request_id":         "requestId",
+		} {
+			if _, hasCamel := body[camel]; !hasCamel {
+				if v, ok := body[snake]; ok {
+					body[camel] = v
+					delete(body, snake)
+				}
+			}
+		}
+
+		if requestID != "" {
+			body["requestId"] = requestID
+		} else if _, ok := body["requestId"]; !ok {
+			b := make([]byte, 16)
+			if _, err := rand.Read(b); err != nil {
+				return fmt.Errorf("failed to generate request ID: %w", err)
+			}
+			body["requestId"] = hex.EncodeToString(b)
+		}
 
 		// Print header info
 		fmt.Printf("Gateway URL:      %s\n", gw.baseURL)
 		fmt.Printf("User ID:          %s\n", gw.userID)
 		fmt.Printf("Tenant ID:        %s\n", gw.tenantID)
+		fmt.Printf("Request ID:       %v (retry with --request-id)\n", body["requestId"])
 		if resourceProfile != nil && resourceProfile != "" {
 			fmt.Printf("Resource Profile: %v\n", resourceProfile)
 		}
 		fmt.Println()
 
 		// Print full request payload as formatted JSON
-		payloadJSON, _ := json.MarshalIndent(body, "", "  ")
 		fmt.Println("Request Payload:")
-		fmt.Println(string(payloadJSON))
+		fmt.Println(formatPayload(body))
 		fmt.Println()
 		fmt.Println("Submitting job...")
 
@@ -120,59 +178,65 @@ var submitCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Println()
-		fmt.Println("Streaming status...")
-		fmt.Println("============================================")
-
-		// Handle Ctrl+C gracefully
-		stop := make(chan os.Signal, 1)
-		signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
-
-		lastStatus := result.Status
-		fmt.Printf("  [%s]  %s\n", time.Now().Format("15:04:05"), lastStatus)
-
-		terminalStates := map[string]bool{
-			"SUCCEEDED": true,
-			"FAILED":    true,
-			"CANCELLED": true,
-			"DELETED":   true,
-		}
-
-		ticker := time.NewTicker(time.Second)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-stop:
-				fmt.Println()
-				return nil
-			case <-ticker.C:
-				jobs, err := fetchJobs(gw)
-				if err != nil {
-					fmt.Printf("  [%s]  polling error: %v\n", time.Now().Format("15:04:05"), err)
-					continue
-				}
-				job := findJob(jobs, result.JobID)
-				if job == nil {
-					// Job no longer in list — it has completed
-					fmt.Println("============================================")
-					fmt.Println("Done!")
-					return nil
-				}
-				if job.Status != lastStatus {
-					fmt.Printf("  [%s]  %s → %s\n", time.Now().Format("15:04:05"), lastStatus, job.Status)
-					lastStatus = job.Status
-				}
-				if terminalStates[lastStatus] {
-					fmt.Println("============================================")
-					fmt.Println("Done!")
-					return nil
-				}
+		return waitForJob(gw, result.JobID)
+	},
+}
+
+// formatPayload renders a submit body for printing, indented and with env var
+// values redacted.
+func formatPayload(body map[string]interface{}) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false) // keep "<redacted>" readable
+	enc.SetIndent("", "  ")
+	enc.Encode(redactedPayload(body))
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// redactedEnvValue replaces env var values in printed payloads.
+const redactedEnvValue = "<redacted>"
+
+// redactedPayload returns a copy of a submit body for printing, with the
+// values of envVars and of every step's envVars replaced by redactedEnvValue.
+func redactedPayload(body map[string]interface{}) map[string]interface{} {
+	out := redactEnvVars(body)
+	if steps, ok := body["steps"].([]interface{}); ok {
+		redacted := make([]interface{}, len(steps))
+		for i, step := range steps {
+			redacted[i] = step
+			if m, ok := step.(map[string]interface{}); ok {
+				redacted[i] = redactEnvVars(m)
 			}
 		}
-	},
+		out["steps"] = redacted
+	}
+	return out
+}
+
+// redactEnvVars returns a shallow copy of m whose envVars (or env_vars)
+// values are redacted.
+func redactEnvVars(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	for _, key := range []string{"envVars", "env_vars"} {
+		vars, ok := m[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		redacted := make(map[string]interface{}, len(vars))
+		for name := range vars {
+			redacted[name] = redactedEnvValue
+		}
+		out[key] = redacted
+	}
+	return out
 }
 
 func init() {
 	submitCmd.Flags().Bool("wait", false, "Stream status changes until the job completes")
+	submitCmd.Flags().String("script", "", "Run this script file instead of a container image")
+	submitCmd.Flags().String("interpreter", "", "Interpreter for --script, e.g. /bin/bash (default: the script's #! line, or /bin/sh)")
+	submitCmd.Flags().String("request-id", "", "Idempotency key; reuse it to retry a submission without creating a second job")
 }
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestFormatPayloadRedactsEnvVars(t *testing.T) {
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(`{
+		"imageUri": "busybox",
+		"envVars": {"TOKEN": "s3cret"},
+		"steps": [{"imageUri": "busybox", "env_vars": {"PASSWORD": "hunter2"}}],
+		"secretEnvVars": {"DB_PASSWORD": {"secret": "db-password"}}
+	}`), &body); err != nil {
+		t.Fatal(err)
+	}
+
+	out := formatPayload(body)
+	for _, secret := range []string{"s3cret", "hunter2"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("printed payload contains %q: %s", secret, out)
+		}
+	}
+	for _, kept := range []string{`"TOKEN": "<redacted>"`, `"PASSWORD": "<redacted>"`, `"secret": "db-password"`} {
+		if !strings.Contains(out, kept) {
+			t.Errorf("printed payload lacks %s: %s", kept, out)
+		}
+	}
+
+	// The request itself still carries the values.
+	if got := body["envVars"].(map[string]interface{})["TOKEN"]; got != "s3cret" {
+		t.Errorf("body envVars TOKEN = %v, want s3cret", got)
+	}
+	step := body["steps"].([]interface{})[0].(map[string]interface{})
+	if got := step["env_vars"].(map[string]interface{})["PASSWORD"]; got != "hunter2" {
+		t.Errorf("body step env_vars PASSWORD = %v, want hunter2", got)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var tasksCmd = &cobra.Command{
+	Use:   "tasks <job-id>",
+	Short: "List a job's tasks",
+	Long:  "jennah tasks <job-id> [--output json]\n\nShows each task of a job (one per BATCH_TASK_INDEX) with its status and\nexit code, plus how many tasks are in each status.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jobID := args[0]
+		outputFmt, _ := cmd.Flags().GetString("output")
+
+		gw, err := newGatewayClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		var result JobTasks
+		if err := gw.post("/jennah.v1.DeploymentService/ListJobTasks", map[string]string{"jobId": jobID}, &result); err != nil {
+			if strings.Contains(err.Error(), "not_found") {
+				return fmt.Errorf("job %q not found", jobID)
+			}
+			return fmt.Errorf("failed to fetch job tasks: %w", err)
+		}
+
+		if outputFmt == "json" {
+			b, _ := json.MarshalIndent(result, "", "  ")
+			fmt.Println(string(b))
+			return nil
+		}
+
+		statuses := make([]string, 0, len(result.TaskCounts))
+		for s := range result.TaskCounts {
+			statuses = append(statuses, s)
+		}
+		sort.Strings(statuses)
+		summary := make([]string, 0, len(statuses))
+		for _, s := range statuses {
+			summary = append(summary, fmt.Sprintf("%s %d", s, result.TaskCounts[s]))
+		}
+		fmt.Printf("Job %s is %s with %d task(s): %s\n", result.JobID, result.Status, result.TaskCount, strings.Join(summary, ", "))
+
+		if len(result.Tasks) == 0 {
+			fmt.Println("No task details available from the batch provider.")
+			return nil
+		}
+
+		fmt.Println()
+		fmt.Printf("%-6s  %-10s  %-4s  %s\n", "INDEX", "STATUS", "EXIT", "MESSAGE")
+		fmt.Println(strings.Repeat("─", 80))
+		for _, t := range result.Tasks {
+			exitCode := "-"
+			if t.ExitCode != nil {
+				exitCode = fmt.Sprint(*t.ExitCode)
+			}
+			message := t.Message
+			if message == "" {
+				message = "-"
+			}
+			fmt.Printf("%-6d  %-10s  %-4s  %s\n", t.Index, t.Status, exitCode, message)
+		}
+		return nil
+	},
+}
+
+// JobTasks is the ListJobTasks response.
+type JobTasks struct {
+	JobID      string           `json:"jobId"`
+	Status     string           `json:"status"`
+	TaskCount  int64            `json:"taskCount,omitempty,string"`
+	TaskCounts map[string]int64 `json:"taskCounts,omitempty"`
+	Tasks      []JobTask        `json:"tasks,omitempty"`
+}
+
+// JobTask is one task of a job.
+type JobTask struct {
+	Index    int64  `json:"index,omitempty,string"`
+	Status   string `json:"status"`
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+func init() {
+	tasksCmd.Flags().String("output", "", "Output format: json")
+}
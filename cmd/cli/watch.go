@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [job-id]",
+	Short: "Stream job status changes",
+	Long: "jennah watch [job-id] [--resume-token <token>] [--output json]\n\n" +
+		"Streams status changes as they happen. With a job ID, replays that job's\n" +
+		"history and stops once it finishes. Without one, follows every job under\n" +
+		"your account until interrupted. Dropped connections are resumed\n" +
+		"automatically without missing events.",
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jobID := ""
+		if len(args) == 1 {
+			jobID = args[0]
+		}
+		resumeToken, _ := cmd.Flags().GetString("resume-token")
+		outputFmt, _ := cmd.Flags().GetString("output")
+
+		gw, err := newGatewayClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if outputFmt != "json" {
+			if jobID != "" {
+				fmt.Printf("Watching job %s (Ctrl+C to stop)...\n", jobID)
+			} else {
+				fmt.Println("Watching all jobs (Ctrl+C to stop)...")
+			}
+		}
+
+		err = watchJobs(ctx, gw, jobID, resumeToken, func(ev JobEvent) {
+			if outputFmt == "json" {
+				b, _ := json.Marshal(ev)
+				fmt.Println(string(b))
+				return
+			}
+			if jobID != "" {
+				printTransition(ev.Transition)
+			} else {
+				printTransitionForJob(ev.JobID, ev.Transition)
+			}
+		})
+		if err != nil {
+			if strings.Contains(err.Error(), "not_found") {
+				return fmt.Errorf("job %q not found", jobID)
+			}
+			return fmt.Errorf("watch failed: %w", err)
+		}
+		return nil
+	},
+}
+
+// JobEvent is one status change delivered by the WatchJobs stream.
+type JobEvent struct {
+	JobID       string        `json:"jobId"`
+	Transition  JobTransition `json:"transition"`
+	ResumeToken string        `json:"resumeToken"`
+}
+
+// watchJobs streams status changes for one job (or all jobs when jobID is
+// empty), reconnecting with the last resume token after connection errors.
+// It returns nil once a single-job stream ends or ctx is cancelled.
+func watchJobs(ctx context.Context, gw *GatewayClient, jobID, resumeToken string, onEvent func(JobEvent)) error {
+	backoff := time.Second
+	for {
+		req := map[string]string{"jobId": jobID, "resumeToken": resumeToken}
+		err := gw.stream(ctx, "/jennah.v1.DeploymentService/WatchJobs", req, func(raw json.RawMessage) error {
+			var ev JobEvent
+			if err := json.Unmarshal(raw, &ev); err != nil {
+				return fmt.Errorf("invalid event: %w", err)
+			}
+			resumeToken = ev.ResumeToken
+			backoff = time.Second
+			onEvent(ev)
+			return nil
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil && jobID != "" {
+			return nil
+		}
+		if err != nil && !isRetryableStreamError(err) {
+			return err
+		}
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "  stream interrupted (%v), reconnecting in %s...\n", err, backoff)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, 30*time.Second)
+	}
+}
+
+// waitForJob streams a job's status changes until it finishes or the user
+// presses Ctrl+C.
+func waitForJob(gw *GatewayClient, jobID string) error {
+	fmt.Println()
+	fmt.Println("Streaming status...")
+	fmt.Println("============================================")
+
+	// Handle Ctrl+C gracefully
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	lastStatus := ""
+	err := watchJobs(ctx, gw, jobID, "", func(ev JobEvent) {
+		printTransition(ev.Transition)
+		lastStatus = ev.Transition.ToStatus
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream status: %w", err)
+	}
+	if ctx.Err() != nil {
+		fmt.Println()
+		return nil
+	}
+
+	fmt.Println("============================================")
+	if lastStatus != "COMPLETED" {
+		fmt.Printf("Job finished with status %s\n", lastStatus)
+	}
+	fmt.Println("Done!")
+	return nil
+}
+
+// isRetryableStreamError reports whether reconnecting could succeed. Errors the
+// gateway returned about the request itself are final.
+func isRetryableStreamError(err error) bool {
+	msg := err.Error()
+	for _, code := range []string{"not_found", "invalid_argument", "unauthenticated", "permission_denied"} {
+		if strings.HasPrefix(msg, code+":") {
+			return false
+		}
+	}
+	return true
+}
+
+// printTransition prints one status change of a single watched job.
+func printTransition(t JobTransition) {
+	fmt.Printf("  [%s]  %s", formatClock(t.TransitionedAt), describeTransition(t))
+	if t.Reason != "" {
+		fmt.Printf("  (%s)", t.Reason)
+	}
+	fmt.Println()
+}
+
+// printTransitionForJob prints one status change in a multi-job stream.
+func printTransitionForJob(jobID string, t JobTransition) {
+	fmt.Printf("  [%s]  %-36s  %s", formatClock(t.TransitionedAt), jobID, describeTransition(t))
+	if t.Reason != "" {
+		fmt.Printf("  (%s)", t.Reason)
+	}
+	fmt.Println()
+}
+
+// describeTransition renders "FROM → TO", or just the status for the initial entry.
+func describeTransition(t JobTransition) string {
+	if t.FromStatus == "" {
+		return t.ToStatus
+	}
+	return t.FromStatus + " → " + t.ToStatus
+}
+
+// formatClock renders the time-of-day part of an RFC 3339 timestamp in display time.
+func formatClock(ts string) string {
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return ts
+	}
+	if loc, err := time.LoadLocation("Asia/Manila"); err == nil {
+		return t.In(loc).Format("15:04:05")
+	}
+	return t.Local().Format("15:04:05")
+}
+
+func init() {
+	watchCmd.Flags().String("resume-token", "", "Resume after the event with this token")
+	watchCmd.Flags().String("output", "", "Output format: json (one event per line)")
+}
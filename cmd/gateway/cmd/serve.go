@@ -15,7 +15,11 @@ import (
 
 	"github.com/alphauslabs/jennah/cmd/gateway/service"
 	jennahv1connect "github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+	"github.com/alphauslabs/jennah/internal/batch"
+	_ "github.com/alphauslabs/jennah/internal/batch/fake" // Register fake log source
+	_ "github.com/alphauslabs/jennah/internal/batch/gcp"  // Register Cloud Logging log source
 	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/envelope"
 	"github.com/alphauslabs/jennah/internal/hashing"
 )
 
@@ -25,6 +29,11 @@ var (
 	dbProjectID     string
 	dbInstance      string
 	dbDatabase      string
+	logSourceName   string
+	logProjectID    string
+	fakeLogDir      string
+	kekProvider     string
+	kekFile         string
 )
 
 var serveCmd = &cobra.Command{
@@ -40,6 +49,11 @@ func init() {
 	serveCmd.Flags().StringVar(&dbProjectID, "db-project-id", "labs-169405", "Database project ID (GCP project for Spanner)")
 	serveCmd.Flags().StringVar(&dbInstance, "db-instance", "alphaus-dev", "Database instance (Spanner instance name)")
 	serveCmd.Flags().StringVar(&dbDatabase, "db-database", "main", "Database name")
+	serveCmd.Flags().StringVar(&logSourceName, "log-source", "gcp", "Where job logs are read from: gcp or fake (empty disables job logs)")
+	serveCmd.Flags().StringVar(&logProjectID, "log-project-id", "labs-169405", "GCP project of jobs whose resource path names none")
+	serveCmd.Flags().StringVar(&fakeLogDir, "fake-log-dir", ".", "Directory of <job>.log files for the fake log source")
+	serveCmd.Flags().StringVar(&kekProvider, "kek-provider", "", "Key-encryption key for revealing encrypted job env vars: keyfile (empty disables reveal of encrypted values)")
+	serveCmd.Flags().StringVar(&kekFile, "kek-file", "", "Base64 key file of the keyfile KEK; must match the workers'")
 }
 
 func runServe(cmd *cobra.Command, args []string) error {
@@ -54,6 +68,15 @@ func runServe(cmd *cobra.Command, args []string) error {
 	defer dbClient.Close()
 	log.Printf("Connected to database: %s/%s/%s", dbProjectID, dbInstance, dbDatabase)
 
+	kek, err := envelope.NewKEK(envelope.Config{Provider: kekProvider, KeyFile: kekFile})
+	if err != nil {
+		return fmt.Errorf("failed to load key encryption key: %w", err)
+	}
+	if kek != nil {
+		dbClient.SetKeyEncryptionKey(kek)
+		log.Printf("Env var reveal enabled with KEK %s", kek.ID())
+	}
+
 	workers := strings.Split(workerIPs, ",")
 	for i, ip := range workers {
 		workers[i] = strings.TrimSpace(ip)
@@ -73,11 +96,30 @@ func runServe(cmd *cobra.Command, args []string) error {
 		log.Printf("Created client for worker at %s", workerURL)
 	}
 
-	gatewayService := service.NewGatewayService(router, workerClients, dbClient)
+	// Job logs are optional: without a log source the log RPCs report Unavailable.
+	var logSource batch.LogSource
+	if logSourceName != "" {
+		logSource, err = batch.NewLogSource(ctx, batch.ProviderConfig{
+			Provider:        logSourceName,
+			ProjectID:       logProjectID,
+			ProviderOptions: map[string]string{"log_dir": fakeLogDir},
+		})
+		if err != nil {
+			log.Printf("Job logs disabled: failed to initialize %s log source: %v", logSourceName, err)
+			logSource = nil
+		} else {
+			log.Printf("Initialized %s log source", logSourceName)
+		}
+	}
+
+	gatewayService := service.NewGatewayService(router, workerClients, dbClient, logSource)
 
 	mux := http.NewServeMux()
 	path, handler := jennahv1connect.NewDeploymentServiceHandler(gatewayService)
-	mux.Handle(path, handler)
+	mux.Handle(path, withoutWriteDeadline(handler,
+		jennahv1connect.DeploymentServiceWatchJobsProcedure,
+		jennahv1connect.DeploymentServiceStreamJobLogsProcedure,
+	))
 	log.Printf("Registered DeploymentService handler at path: %s", path)
 
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -103,7 +145,16 @@ func runServe(cmd *cobra.Command, args []string) error {
 		log.Println("Available endpoints:")
 		log.Printf("  • POST %sGetCurrentTenant", path)
 		log.Printf("  • POST %sSubmitJob", path)
+		log.Printf("  • POST %sRerunJob", path)
 		log.Printf("  • POST %sListJobs", path)
+		log.Printf("  • POST %sGetJob", path)
+		log.Printf("  • POST %sGetJobHistory", path)
+		log.Printf("  • POST %sListJobAttempts", path)
+		log.Printf("  • POST %sListJobTasks", path)
+		log.Printf("  • POST %sWatchJobs (server stream)", path)
+		log.Printf("  • POST %sGetJobLogs", path)
+		log.Printf("  • POST %sStreamJobLogs (server stream)", path)
+		log.Printf("  • POST %sCancelJob", path)
 		log.Printf("  • GET  /health")
 		log.Println("OAuth-enabled - tenantId auto-generated from auth headers")
 		log.Println("Database: Cloud Spanner (persistent tenant storage)")
@@ -126,3 +177,20 @@ func runServe(cmd *cobra.Command, args []string) error {
 	log.Println("Gateway stopped")
 	return nil
 }
+
+// withoutWriteDeadline lifts the server WriteTimeout for the given streaming
+// procedures so long-lived streams are not cut off, while unary RPCs keep it.
+func withoutWriteDeadline(next http.Handler, procedures ...string) http.Handler {
+	streaming := make(map[string]bool, len(procedures))
+	for _, p := range procedures {
+		streaming[p] = true
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if streaming[r.URL.Path] {
+			if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+				log.Printf("Failed to clear write deadline for %s: %v", r.URL.Path, err)
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -7,9 +7,12 @@ import (
 	"log"
 	"time"
 
+	"cloud.google.com/go/spanner"
 	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
 
 	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
 )
 
 func (s *GatewayService) GetCurrentTenant(
@@ -63,15 +66,20 @@ func (s *GatewayService) SubmitJob(
 	}
 	log.Printf("Job submission from user %s (tenantId=%s)", oauthUser.Email, tenantId)
 
-	if req.Msg.ImageUri == "" {
-		log.Printf("Error: imageUri is empty")
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("imageUri is required"))
+	if req.Msg.ImageUri == "" && req.Msg.Script == nil && len(req.Msg.Steps) == 0 {
+		log.Printf("Error: no imageUri, script or steps")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("one of imageUri, script and steps is required"))
 	}
 
 	//workerIP := s.router.GetWorkerIP(tenantId)
 
 	//create unique routing key for each job submission to ensure better load distribution across workers
 	routingKey := fmt.Sprintf("%s-%d", tenantId, time.Now().UnixNano())
+	if req.Msg.RequestId != "" {
+		// Send retries of a request to the same worker; the worker's database
+		// check is what actually deduplicates them.
+		routingKey = tenantId + "/" + req.Msg.RequestId
+	}
 	workerIP := s.router.GetWorkerIP(routingKey)
 	if workerIP == "" {
 		log.Printf("No worker found for routingKey: %s", routingKey)
@@ -86,10 +94,27 @@ func (s *GatewayService) SubmitJob(
 	}
 
 	workerReq := connect.NewRequest(&jennahv1.SubmitJobRequest{
-		ImageUri:         req.Msg.ImageUri,
-		EnvVars:          req.Msg.EnvVars,
-		ResourceProfile:  req.Msg.ResourceProfile,
-		ResourceOverride: req.Msg.ResourceOverride,
+		ImageUri:          req.Msg.ImageUri,
+		EnvVars:           req.Msg.EnvVars,
+		ResourceProfile:   req.Msg.ResourceProfile,
+		ResourceOverride:  req.Msg.ResourceOverride,
+		RetryPolicy:       req.Msg.RetryPolicy,
+		LifecyclePolicies: req.Msg.LifecyclePolicies,
+		MaxTaskRetries:    req.Msg.MaxTaskRetries,
+		Commands:          req.Msg.Commands,
+		Entrypoint:        req.Msg.Entrypoint,
+		ContainerOptions:  req.Msg.ContainerOptions,
+		Script:            req.Msg.Script,
+		Steps:             req.Msg.Steps,
+		TaskCount:         req.Msg.TaskCount,
+		Parallelism:       req.Msg.Parallelism,
+		Volumes:           req.Msg.Volumes,
+		Labels:            req.Msg.Labels,
+		Priority:          req.Msg.Priority,
+		Allocation:        req.Msg.Allocation,
+		PreemptionPolicy:  req.Msg.PreemptionPolicy,
+		SecretEnvVars:     req.Msg.SecretEnvVars,
+		RequestId:         req.Msg.RequestId,
 	})
 	workerReq.Header().Set("X-Tenant-Id", tenantId)
 	// Pass user info so the worker can upsert the tenant row in its own DB connection
@@ -100,7 +125,7 @@ func (s *GatewayService) SubmitJob(
 	response, err := workerClient.SubmitJob(ctx, workerReq)
 	if err != nil {
 		log.Printf("ERROR: Worker %s failed: %v", workerIP, err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("worker failed: %w", err))
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
 	}
 
 	response.Msg.WorkerAssigned = workerIP
@@ -110,6 +135,58 @@ func (s *GatewayService) SubmitJob(
 	return response, nil
 }
 
+func (s *GatewayService) RerunJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.RerunJobRequest],
+) (*connect.Response[jennahv1.RerunJobResponse], error) {
+	log.Printf("Received rerun job request")
+
+	oauthUser, err := extractOAuthUser(req.Header())
+	if err != nil {
+		log.Printf("OAuth authentication failed: %v", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	tenantId, err := s.getOrCreateTenant(oauthUser)
+	if err != nil {
+		log.Printf("Failed to get or create tenant: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+
+	// A rerun is a new submission, so it is spread across workers like SubmitJob.
+	routingKey := fmt.Sprintf("%s-%d", tenantId, time.Now().UnixNano())
+	workerIP, workerClient, err := s.workerFor(routingKey)
+	if err != nil {
+		log.Printf("Failed to select worker for rerun of job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	log.Printf("Forwarding rerun of job %s (tenantId=%s) to worker %s", req.Msg.JobId, tenantId, workerIP)
+
+	workerReq := connect.NewRequest(&jennahv1.RerunJobRequest{
+		JobId:            req.Msg.JobId,
+		EnvVars:          req.Msg.EnvVars,
+		ResourceProfile:  req.Msg.ResourceProfile,
+		ResourceOverride: req.Msg.ResourceOverride,
+		Labels:           req.Msg.Labels,
+	})
+	setWorkerHeaders(workerReq.Header(), tenantId, oauthUser)
+
+	response, err := workerClient.RerunJob(ctx, workerReq)
+	if err != nil {
+		log.Printf("ERROR: Worker %s failed to rerun job %s: %v", workerIP, req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
+	}
+
+	response.Msg.WorkerAssigned = workerIP
+	log.Printf("Job %s rerun as %s on worker %s, status=%s",
+		req.Msg.JobId, response.Msg.JobId, workerIP, response.Msg.Status)
+	return response, nil
+}
+
 func (s *GatewayService) ListJobs(
 	ctx context.Context,
 	req *connect.Request[jennahv1.ListJobsRequest],
@@ -129,7 +206,16 @@ func (s *GatewayService) ListJobs(
 	}
 	log.Printf("List jobs request from user %s (tenantId=%s)", oauthUser.Email, tenantId)
 
-	jobs, err := s.dbClient.ListJobs(ctx, tenantId)
+	filter, pageSize, err := jobFilterFromRequest(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	cursor, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	jobs, next, err := s.dbClient.ListJobsPage(ctx, tenantId, filter, cursor, pageSize)
 	if err != nil {
 		log.Printf("Failed to list jobs from database: %v", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list jobs: %w", err))
@@ -139,22 +225,258 @@ func (s *GatewayService) ListJobs(
 	// Convert database jobs to API response format
 	protoJobs := make([]*jennahv1.Job, 0, len(jobs))
 	for _, job := range jobs {
-		protoJob := &jennahv1.Job{
-			JobId:     job.JobId,
-			TenantId:  job.TenantId,
-			ImageUri:  job.ImageUri,
-			Status:    job.Status,
-			CreatedAt: job.CreatedAt.Format(time.RFC3339),
-		}
-		protoJobs = append(protoJobs, protoJob)
+		protoJobs = append(protoJobs, jobToProto(job))
 	}
 
 	log.Printf("Successfully listed %d jobs for tenant %s", len(protoJobs), tenantId)
 	return connect.NewResponse(&jennahv1.ListJobsResponse{
-		Jobs: protoJobs,
+		Jobs:          protoJobs,
+		NextPageToken: encodePageToken(next),
+	}), nil
+}
+
+func (s *GatewayService) GetJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetJobRequest],
+) (*connect.Response[jennahv1.GetJobResponse], error) {
+	log.Printf("Received get job request")
+
+	oauthUser, err := extractOAuthUser(req.Header())
+	if err != nil {
+		log.Printf("OAuth authentication failed: %v", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	tenantId, err := s.getOrCreateTenant(oauthUser)
+	if err != nil {
+		log.Printf("Failed to get or create tenant: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+
+	// Jobs are keyed by (TenantId, JobId), so another tenant's job is simply not found.
+	job, err := s.dbClient.GetJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", req.Msg.JobId))
+		}
+		log.Printf("Failed to get job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+	}
+
+	protoJob := jobToProto(job)
+	if req.Msg.RevealEnvVars {
+		spec, err := s.dbClient.DecryptedSpec(ctx, job)
+		if errors.Is(err, database.ErrEncryptionDisabled) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if err != nil {
+			log.Printf("Failed to decrypt spec of job %s: %v", job.JobId, err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read job env vars: %w", err))
+		}
+		if spec != nil {
+			protoJob.Spec = specToProto(spec)
+		}
+		log.Printf("Revealed env vars of job %s to %s (tenant %s)", job.JobId, oauthUser.Email, tenantId)
+	}
+
+	log.Printf("Retrieved job %s for tenant %s", job.JobId, tenantId)
+	return connect.NewResponse(&jennahv1.GetJobResponse{
+		Job: protoJob,
 	}), nil
 }
 
+func (s *GatewayService) GetJobHistory(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetJobHistoryRequest],
+) (*connect.Response[jennahv1.GetJobHistoryResponse], error) {
+	log.Printf("Received get job history request")
+
+	oauthUser, err := extractOAuthUser(req.Header())
+	if err != nil {
+		log.Printf("OAuth authentication failed: %v", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	tenantId, err := s.getOrCreateTenant(oauthUser)
+	if err != nil {
+		log.Printf("Failed to get or create tenant: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+
+	// Look the job up first so unknown jobs are NotFound rather than an empty timeline.
+	job, err := s.dbClient.GetJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", req.Msg.JobId))
+		}
+		log.Printf("Failed to get job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+	}
+
+	transitions, err := s.dbClient.GetJobTransitions(ctx, tenantId, job.JobId)
+	if err != nil {
+		log.Printf("Failed to get transitions for job %s: %v", job.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job history: %w", err))
+	}
+
+	protoTransitions := make([]*jennahv1.JobTransition, 0, len(transitions))
+	for _, t := range transitions {
+		protoTransitions = append(protoTransitions, transitionToProto(t))
+	}
+
+	log.Printf("Retrieved %d transitions for job %s", len(protoTransitions), job.JobId)
+	return connect.NewResponse(&jennahv1.GetJobHistoryResponse{
+		JobId:         job.JobId,
+		CurrentStatus: job.Status,
+		Transitions:   protoTransitions,
+	}), nil
+}
+
+func (s *GatewayService) ListJobAttempts(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListJobAttemptsRequest],
+) (*connect.Response[jennahv1.ListJobAttemptsResponse], error) {
+	log.Printf("Received list job attempts request")
+
+	oauthUser, err := extractOAuthUser(req.Header())
+	if err != nil {
+		log.Printf("OAuth authentication failed: %v", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	tenantId, err := s.getOrCreateTenant(oauthUser)
+	if err != nil {
+		log.Printf("Failed to get or create tenant: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+
+	job, err := s.dbClient.GetJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", req.Msg.JobId))
+		}
+		log.Printf("Failed to get job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+	}
+
+	attempts, err := s.dbClient.ListJobAttempts(ctx, tenantId, job.JobId)
+	if err != nil {
+		log.Printf("Failed to list attempts for job %s: %v", job.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list job attempts: %w", err))
+	}
+
+	protoAttempts := make([]*jennahv1.JobAttempt, 0, len(attempts))
+	for _, a := range attempts {
+		protoAttempts = append(protoAttempts, attemptToProto(a))
+	}
+
+	log.Printf("Retrieved %d attempts for job %s", len(protoAttempts), job.JobId)
+	return connect.NewResponse(&jennahv1.ListJobAttemptsResponse{
+		JobId:    job.JobId,
+		Attempts: protoAttempts,
+	}), nil
+}
+
+func (s *GatewayService) ListJobTasks(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListJobTasksRequest],
+) (*connect.Response[jennahv1.ListJobTasksResponse], error) {
+	log.Printf("Received list job tasks request")
+
+	oauthUser, err := extractOAuthUser(req.Header())
+	if err != nil {
+		log.Printf("OAuth authentication failed: %v", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	tenantId, err := s.getOrCreateTenant(oauthUser)
+	if err != nil {
+		log.Printf("Failed to get or create tenant: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+
+	// Task statuses come from the batch provider, which only workers talk to.
+	workerIP, workerClient, err := s.workerFor(req.Msg.JobId)
+	if err != nil {
+		log.Printf("Failed to select worker for job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	workerReq := connect.NewRequest(&jennahv1.ListJobTasksRequest{
+		JobId: req.Msg.JobId,
+	})
+	setWorkerHeaders(workerReq.Header(), tenantId, oauthUser)
+
+	response, err := workerClient.ListJobTasks(ctx, workerReq)
+	if err != nil {
+		log.Printf("ERROR: Worker %s failed to list tasks of job %s: %v", workerIP, req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
+	}
+
+	log.Printf("Retrieved %d tasks for job %s", len(response.Msg.Tasks), req.Msg.JobId)
+	return response, nil
+}
+
+func (s *GatewayService) CancelJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.CancelJobRequest],
+) (*connect.Response[jennahv1.CancelJobResponse], error) {
+	log.Printf("Received cancel job request")
+
+	oauthUser, err := extractOAuthUser(req.Header())
+	if err != nil {
+		log.Printf("OAuth authentication failed: %v", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	tenantId, err := s.getOrCreateTenant(oauthUser)
+	if err != nil {
+		log.Printf("Failed to get or create tenant: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+
+	workerIP, workerClient, err := s.workerFor(req.Msg.JobId)
+	if err != nil {
+		log.Printf("Failed to select worker for job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	log.Printf("Forwarding cancel for job %s (tenantId=%s) to worker %s", req.Msg.JobId, tenantId, workerIP)
+
+	workerReq := connect.NewRequest(&jennahv1.CancelJobRequest{
+		JobId: req.Msg.JobId,
+	})
+	setWorkerHeaders(workerReq.Header(), tenantId, oauthUser)
+
+	response, err := workerClient.CancelJob(ctx, workerReq)
+	if err != nil {
+		log.Printf("ERROR: Worker %s failed to cancel job %s: %v", workerIP, req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
+	}
+
+	log.Printf("Cancelled job %s for tenant %s", response.Msg.JobId, tenantId)
+	return response, nil
+}
+
 func (s *GatewayService) DeleteJob(
 	ctx context.Context,
 	req *connect.Request[jennahv1.DeleteJobRequest],
@@ -177,14 +499,324 @@ func (s *GatewayService) DeleteJob(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
 	}
 
-	if err := s.dbClient.DeleteJob(ctx, tenantId, req.Msg.JobId); err != nil {
-		log.Printf("Failed to delete job %s: %v", req.Msg.JobId, err)
+	job, err := s.dbClient.GetJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", req.Msg.JobId))
+		}
+		log.Printf("Failed to get job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+	}
+
+	resp := &jennahv1.DeleteJobResponse{
+		JobId:          job.JobId,
+		PreviousStatus: job.Status,
+	}
+	if job.CloudJobResourcePath != nil {
+		resp.CloudJobResourcePath = *job.CloudJobResourcePath
+	}
+
+	// Deleting an active job would orphan its batch job, so it must be cancelled first.
+	cancelled := false
+	if !database.IsTerminalStatus(job.Status) {
+		if !req.Msg.Force {
+			return nil, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("job %s is %s; cancel it first or delete with force", job.JobId, job.Status))
+		}
+
+		workerIP, workerClient, err := s.workerFor(job.JobId)
+		if err != nil {
+			log.Printf("Failed to select worker for job %s: %v", job.JobId, err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		log.Printf("Forwarding cancel before delete for job %s (tenantId=%s) to worker %s", job.JobId, tenantId, workerIP)
+
+		workerReq := connect.NewRequest(&jennahv1.CancelJobRequest{
+			JobId: job.JobId,
+		})
+		setWorkerHeaders(workerReq.Header(), tenantId, oauthUser)
+
+		_, err = workerClient.CancelJob(ctx, workerReq)
+		switch {
+		case err == nil:
+			cancelled = true
+			resp.CloudJobCancelled = resp.CloudJobResourcePath != ""
+		case connect.CodeOf(err) == connect.CodeFailedPrecondition:
+			// The job finished on its own since we read it; nothing left to stop.
+			log.Printf("Job %s finished before it could be cancelled: %v", job.JobId, err)
+		default:
+			log.Printf("ERROR: Worker %s failed to cancel job %s: %v", workerIP, job.JobId, err)
+			return nil, connect.NewError(connect.CodeOf(err),
+				fmt.Errorf("job was not deleted because cancelling it failed: %w", err))
+		}
+	}
+
+	if err := s.dbClient.DeleteJob(ctx, tenantId, job.JobId); err != nil {
+		log.Printf("Failed to delete job %s: %v", job.JobId, err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete job: %w", err))
 	}
+	resp.RecordDeleted = true
 
-	log.Printf("Deleted job %s for tenant %s", req.Msg.JobId, tenantId)
-	return connect.NewResponse(&jennahv1.DeleteJobResponse{
-		JobId:   req.Msg.JobId,
-		Message: "job deleted successfully",
-	}), nil
+	switch {
+	case resp.CloudJobCancelled:
+		resp.Message = fmt.Sprintf("cancelled batch job %s and deleted job record", resp.CloudJobResourcePath)
+	case cancelled:
+		resp.Message = "cancelled job before it reached the batch provider and deleted job record"
+	default:
+		resp.Message = "deleted job record"
+	}
+
+	log.Printf("Deleted job %s for tenant %s (was %s, cloud job cancelled: %t)", job.JobId, tenantId, job.Status, resp.CloudJobCancelled)
+	return connect.NewResponse(resp), nil
+}
+
+// jobToProto converts a database job into its API representation.
+func jobToProto(job *database.Job) *jennahv1.Job {
+	protoJob := &jennahv1.Job{
+		JobId:           job.JobId,
+		TenantId:        job.TenantId,
+		ImageUri:        job.ImageUri,
+		Status:          job.Status,
+		CreatedAt:       job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       job.UpdatedAt.Format(time.RFC3339),
+		ScheduledAt:     formatOptionalTime(job.ScheduledAt),
+		StartedAt:       formatOptionalTime(job.StartedAt),
+		CompletedAt:     formatOptionalTime(job.CompletedAt),
+		RetryCount:      job.RetryCount,
+		MaxRetries:      job.MaxRetries,
+		PreemptionCount: job.PreemptionCount,
+		Commands:        job.Commands,
+		NextAttemptAt:   formatOptionalTime(job.NextAttemptAt),
+		ExitCode:        optionalExitCode(job.ExitCode),
+	}
+	if job.FailureCategory != nil {
+		protoJob.FailureCategory = *job.FailureCategory
+	}
+	if events, err := job.Events(); err != nil {
+		log.Printf("Job %s has unreadable provider events: %v", job.JobId, err)
+	} else {
+		protoJob.ProviderEvents = eventsToProto(events)
+	}
+	if labels, err := job.LabelMap(); err != nil {
+		log.Printf("Job %s has unreadable labels: %v", job.JobId, err)
+	} else {
+		protoJob.Labels = labels
+	}
+	if job.ErrorMessage != nil {
+		protoJob.ErrorMessage = *job.ErrorMessage
+	}
+	if job.CloudJobResourcePath != nil {
+		protoJob.CloudJobResourcePath = *job.CloudJobResourcePath
+	}
+	if job.ClonedFromJobId != nil {
+		protoJob.ClonedFromJobId = *job.ClonedFromJobId
+	}
+	if job.ResourceProfile != nil {
+		protoJob.ResourceProfile = *job.ResourceProfile
+	}
+	if job.CpuMillis != nil || job.MemoryMiB != nil || job.MaxRunDurationSeconds != nil {
+		protoJob.Resources = &jennahv1.JobResources{}
+		if job.CpuMillis != nil {
+			protoJob.Resources.CpuMillis = *job.CpuMillis
+		}
+		if job.MemoryMiB != nil {
+			protoJob.Resources.MemoryMib = *job.MemoryMiB
+		}
+		if job.MaxRunDurationSeconds != nil {
+			protoJob.Resources.MaxRunDurationSeconds = *job.MaxRunDurationSeconds
+		}
+	}
+	spec, err := job.Spec()
+	if err != nil {
+		log.Printf("Job %s has an unreadable spec: %v", job.JobId, err)
+	} else if spec != nil {
+		spec.RedactEnvVars()
+		protoJob.Spec = specToProto(spec)
+	}
+	return protoJob
+}
+
+// specToProto converts a stored job spec into its API representation.
+func specToProto(spec *database.JobSpec) *jennahv1.JobSpec {
+	protoSpec := &jennahv1.JobSpec{
+		Version:         int32(spec.Version),
+		ImageUri:        spec.ImageURI,
+		Commands:        spec.Commands,
+		Entrypoint:      spec.Entrypoint,
+		EnvVars:         spec.EnvVars,
+		ResourceProfile: spec.ResourceProfile,
+		Resources: &jennahv1.JobResources{
+			CpuMillis:             spec.Resources.CPUMillis,
+			MemoryMib:             spec.Resources.MemoryMiB,
+			MaxRunDurationSeconds: spec.Resources.MaxRunDurationSeconds,
+		},
+	}
+	if o := spec.ResourceOverride; o != nil {
+		protoSpec.ResourceOverride = &jennahv1.ResourceOverride{
+			CpuMillis:             o.CPUMillis,
+			MemoryMib:             o.MemoryMiB,
+			MaxRunDurationSeconds: o.MaxRunDurationSeconds,
+		}
+	}
+	protoSpec.Script = scriptToProto(spec.Script)
+	for _, step := range spec.Steps {
+		protoSpec.Steps = append(protoSpec.Steps, &jennahv1.Step{
+			Name:             step.Name,
+			ImageUri:         step.ImageURI,
+			Commands:         step.Commands,
+			Entrypoint:       step.Entrypoint,
+			ContainerOptions: containerOptionsToProto(step.ContainerOptions),
+			Script:           scriptToProto(step.Script),
+			Barrier:          step.Barrier,
+			EnvVars:          step.EnvVars,
+			Background:       step.Background,
+			IgnoreExitStatus: step.IgnoreExitStatus,
+		})
+	}
+	protoSpec.ContainerOptions = containerOptionsToProto(spec.ContainerOptions)
+	if p := spec.RetryPolicy; p != nil {
+		protoSpec.RetryPolicy = &jennahv1.RetryPolicy{
+			MaxAttempts:           p.MaxAttempts,
+			InitialBackoffSeconds: p.InitialBackoffSeconds,
+			MaxBackoffSeconds:     p.MaxBackoffSeconds,
+			BackoffMultiplier:     p.BackoffMultiplier,
+			RetryOn:               p.RetryOn,
+		}
+	}
+	for _, p := range spec.LifecyclePolicies {
+		protoSpec.LifecyclePolicies = append(protoSpec.LifecyclePolicies, &jennahv1.LifecyclePolicy{
+			Action:    p.Action,
+			ExitCodes: p.ExitCodes,
+		})
+	}
+	protoSpec.MaxTaskRetries = spec.MaxTaskRetries
+	protoSpec.TaskCount = spec.TaskCount
+	protoSpec.Parallelism = spec.Parallelism
+	for _, v := range spec.Volumes {
+		protoVolume := &jennahv1.Volume{MountPath: v.MountPath, ReadOnly: v.ReadOnly}
+		if g := v.GCS; g != nil {
+			protoVolume.Gcs = &jennahv1.GcsVolume{Bucket: g.Bucket, Path: g.Path}
+		}
+		if n := v.NFS; n != nil {
+			protoVolume.Nfs = &jennahv1.NfsVolume{Server: n.Server, RemotePath: n.RemotePath}
+		}
+		if d := v.Disk; d != nil {
+			protoVolume.Disk = &jennahv1.DiskVolume{SizeGb: d.SizeGB, Type: d.Type}
+		}
+		protoSpec.Volumes = append(protoSpec.Volumes, protoVolume)
+	}
+	protoSpec.Labels = spec.Labels
+	protoSpec.Priority = spec.Priority
+	if a := spec.Allocation; a != nil {
+		protoSpec.Allocation = &jennahv1.Allocation{
+			MachineType:       a.MachineType,
+			ProvisioningModel: a.ProvisioningModel,
+			Zones:             a.Zones,
+		}
+	}
+	if p := spec.PreemptionPolicy; p != nil {
+		protoSpec.PreemptionPolicy = &jennahv1.PreemptionPolicy{MaxRetries: p.MaxRetries, FallbackToStandard: p.FallbackToStandard}
+	}
+	if len(spec.SecretEnvVars) > 0 {
+		protoSpec.SecretEnvVars = make(map[string]*jennahv1.SecretEnvVar, len(spec.SecretEnvVars))
+		for name, ref := range spec.SecretEnvVars {
+			protoSpec.SecretEnvVars[name] = &jennahv1.SecretEnvVar{Secret: ref.Secret, Version: ref.Version}
+		}
+	}
+	return protoSpec
+}
+
+// scriptToProto converts a stored script runnable, keeping nil as nil.
+func scriptToProto(sc *database.Script) *jennahv1.Script {
+	if sc == nil {
+		return nil
+	}
+	return &jennahv1.Script{Text: sc.Text, Interpreter: sc.Interpreter}
+}
+
+// containerOptionsToProto converts stored container options, keeping nil as nil.
+func containerOptionsToProto(o *database.ContainerOptions) *jennahv1.ContainerOptions {
+	if o == nil {
+		return nil
+	}
+	return &jennahv1.ContainerOptions{WorkingDir: o.WorkingDir, User: o.User}
+}
+
+// attemptToProto converts a recorded job attempt into its API representation.
+func attemptToProto(a *database.JobAttempt) *jennahv1.JobAttempt {
+	protoAttempt := &jennahv1.JobAttempt{
+		AttemptNumber: a.AttemptNumber,
+		Status:        a.Status,
+		StartedAt:     formatOptionalTime(a.StartedAt),
+		EndedAt:       formatOptionalTime(a.EndedAt),
+		ExitCode:      optionalExitCode(a.ExitCode),
+	}
+	if a.FailureCategory != nil {
+		protoAttempt.FailureCategory = *a.FailureCategory
+	}
+	if events, err := a.Events(); err != nil {
+		log.Printf("Attempt %d of job %s has unreadable provider events: %v", a.AttemptNumber, a.JobId, err)
+	} else {
+		protoAttempt.ProviderEvents = eventsToProto(events)
+	}
+	if a.ProviderJobId != nil {
+		protoAttempt.ProviderJobId = *a.ProviderJobId
+	}
+	if a.CloudJobResourcePath != nil {
+		protoAttempt.CloudJobResourcePath = *a.CloudJobResourcePath
+	}
+	if a.FailureClass != nil {
+		protoAttempt.FailureClass = *a.FailureClass
+	}
+	if a.ErrorMessage != nil {
+		protoAttempt.ErrorMessage = *a.ErrorMessage
+	}
+	return protoAttempt
+}
+
+// transitionToProto converts a recorded transition. Timestamps keep sub-second
+// precision so durations between close transitions stay meaningful.
+func transitionToProto(t *database.JobStateTransition) *jennahv1.JobTransition {
+	protoTransition := &jennahv1.JobTransition{
+		TransitionId:   t.TransitionId,
+		ToStatus:       t.ToStatus,
+		TransitionedAt: t.TransitionedAt.Format(time.RFC3339Nano),
+	}
+	if t.FromStatus != nil {
+		protoTransition.FromStatus = *t.FromStatus
+	}
+	if t.Reason != nil {
+		protoTransition.Reason = *t.Reason
+	}
+	return protoTransition
+}
+
+// eventsToProto converts stored provider events into their API representation.
+func eventsToProto(events []database.ProviderEvent) []*jennahv1.ProviderEvent {
+	protoEvents := make([]*jennahv1.ProviderEvent, 0, len(events))
+	for _, e := range events {
+		protoEvents = append(protoEvents, &jennahv1.ProviderEvent{
+			EventTime:   e.Time.Format(time.RFC3339),
+			Type:        e.Type,
+			Description: e.Description,
+		})
+	}
+	return protoEvents
+}
+
+// optionalExitCode converts a stored exit code, keeping unset as unset.
+func optionalExitCode(code *int64) *int32 {
+	if code == nil {
+		return nil
+	}
+	c := int32(*code)
+	return &c
+}
+
+// formatOptionalTime formats t as RFC 3339, or returns "" when t is unset.
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
 }
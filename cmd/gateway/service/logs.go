@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/batch"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+const (
+	defaultLogPageSize = 500
+	maxLogPageSize     = 1000
+
+	// logPollInterval is how often StreamJobLogs checks for new log entries
+	// while they keep arriving. Each empty poll doubles the wait, up to
+	// logMaxPollInterval, so idle streams stay within the Cloud Logging
+	// read quota.
+	logPollInterval    = 5 * time.Second
+	logMaxPollInterval = 30 * time.Second
+	// logDrainPeriod is how long StreamJobLogs keeps reading after the job
+	// finishes, since providers ship the last lines with some delay.
+	logDrainPeriod = 10 * time.Second
+)
+
+// logTarget is the provider job whose logs a request reads.
+type logTarget struct {
+	attemptNumber     int64
+	cloudResourcePath string // Empty while the attempt has not been submitted.
+	providerJobUID    string // Empty for attempts submitted before UIDs were stored.
+	finished          bool
+}
+
+func (s *GatewayService) GetJobLogs(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetJobLogsRequest],
+) (*connect.Response[jennahv1.GetJobLogsResponse], error) {
+	log.Printf("Received get job logs request")
+
+	if s.logSource == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("job logs are not configured on this gateway"))
+	}
+
+	oauthUser, err := extractOAuthUser(req.Header())
+	if err != nil {
+		log.Printf("OAuth authentication failed: %v", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	tenantId, err := s.getOrCreateTenant(oauthUser)
+	if err != nil {
+		log.Printf("Failed to get or create tenant: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+	if req.Msg.PageSize < 0 || req.Msg.PageSize > maxLogPageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page_size must be between 0 and %d", maxLogPageSize))
+	}
+	pageSize := int(req.Msg.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultLogPageSize
+	}
+	since, err := parseSince(req.Msg.Since)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	target, err := s.resolveLogTarget(ctx, tenantId, req.Msg.JobId, req.Msg.AttemptNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &jennahv1.GetJobLogsResponse{
+		JobId:         req.Msg.JobId,
+		AttemptNumber: target.attemptNumber,
+		Entries:       []*jennahv1.LogEntry{},
+	}
+	if target.cloudResourcePath == "" {
+		return connect.NewResponse(resp), nil
+	}
+
+	page, err := s.logSource.ReadLogs(ctx, batch.LogRequest{
+		CloudResourcePath: target.cloudResourcePath,
+		ProviderJobUID:    target.providerJobUID,
+		Since:             since,
+		PageSize:          pageSize,
+		PageToken:         req.Msg.PageToken,
+	})
+	if err != nil {
+		log.Printf("Failed to read logs for job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read job logs: %w", err))
+	}
+
+	for _, e := range page.Entries {
+		resp.Entries = append(resp.Entries, logEntryToProto(e))
+	}
+	resp.NextPageToken = page.NextPageToken
+
+	log.Printf("Retrieved %d log entries for job %s (attempt %d)", len(resp.Entries), req.Msg.JobId, target.attemptNumber)
+	return connect.NewResponse(resp), nil
+}
+
+func (s *GatewayService) StreamJobLogs(
+	ctx context.Context,
+	req *connect.Request[jennahv1.StreamJobLogsRequest],
+	stream *connect.ServerStream[jennahv1.StreamJobLogsResponse],
+) error {
+	log.Printf("Received stream job logs request")
+
+	if s.logSource == nil {
+		return connect.NewError(connect.CodeUnavailable, errors.New("job logs are not configured on this gateway"))
+	}
+
+	oauthUser, err := extractOAuthUser(req.Header())
+	if err != nil {
+		log.Printf("OAuth authentication failed: %v", err)
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	tenantId, err := s.getOrCreateTenant(oauthUser)
+	if err != nil {
+		log.Printf("Failed to get or create tenant: %v", err)
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.JobId == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+	since, err := parseSince(req.Msg.Since)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	target, err := s.resolveLogTarget(ctx, tenantId, req.Msg.JobId, req.Msg.AttemptNumber)
+	if err != nil {
+		return err
+	}
+	// Keep following the same attempt even if a retry starts a newer one.
+	attemptNumber := target.attemptNumber
+
+	log.Printf("Streaming logs for job %s (attempt %d)", req.Msg.JobId, attemptNumber)
+
+	// Each poll re-reads from the newest timestamp sent so far; seen holds the
+	// IDs already sent at exactly that timestamp.
+	seen := make(map[string]bool)
+	var drainUntil time.Time
+	pollInterval := logPollInterval
+	for {
+		gotEntries := false
+		if target.cloudResourcePath != "" {
+			entries, err := s.readNewLogs(ctx, target, &since, seen)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				log.Printf("Failed to read logs for job %s: %v", req.Msg.JobId, err)
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read job logs: %w", err))
+			}
+			if len(entries) > 0 {
+				gotEntries = true
+				if err := stream.Send(&jennahv1.StreamJobLogsResponse{Entries: entries}); err != nil {
+					log.Printf("Log stream for job %s closed: %v", req.Msg.JobId, err)
+					return nil
+				}
+			}
+		}
+
+		if !drainUntil.IsZero() {
+			if time.Now().After(drainUntil) {
+				return nil
+			}
+		} else if target.finished {
+			drainUntil = time.Now().Add(logDrainPeriod)
+		}
+
+		// Poll at the base rate while draining, so the last lines are read
+		// before the drain period ends.
+		pollInterval = nextLogPollInterval(pollInterval, gotEntries || target.finished)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(pollInterval):
+		}
+
+		if drainUntil.IsZero() {
+			target, err = s.resolveLogTarget(ctx, tenantId, req.Msg.JobId, attemptNumber)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+}
+
+// nextLogPollInterval returns the wait before the next StreamJobLogs poll:
+// the base interval after a poll that found entries (or when reset is set
+// for another reason), otherwise double the last wait, capped at
+// logMaxPollInterval.
+func nextLogPollInterval(last time.Duration, reset bool) time.Duration {
+	if reset {
+		return logPollInterval
+	}
+	return min(2*last, logMaxPollInterval)
+}
+
+// readNewLogs reads every page of entries at or after *since, drops the ones
+// in seen, and advances *since and seen past what it returns.
+func (s *GatewayService) readNewLogs(ctx context.Context, target *logTarget, since *time.Time, seen map[string]bool) ([]*jennahv1.LogEntry, error) {
+	var entries []*jennahv1.LogEntry
+	pageToken := ""
+	for {
+		page, err := s.logSource.ReadLogs(ctx, batch.LogRequest{
+			CloudResourcePath: target.cloudResourcePath,
+			ProviderJobUID:    target.providerJobUID,
+			Since:             *since,
+			PageSize:          maxLogPageSize,
+			PageToken:         pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range page.Entries {
+			if e.Timestamp.Before(*since) || (e.Timestamp.Equal(*since) && seen[e.ID]) {
+				continue
+			}
+			if e.Timestamp.After(*since) {
+				*since = e.Timestamp
+				clear(seen)
+			}
+			seen[e.ID] = true
+			entries = append(entries, logEntryToProto(e))
+		}
+
+		if page.NextPageToken == "" {
+			return entries, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// resolveLogTarget checks that the job belongs to the tenant and finds the
+// provider job of the requested attempt (0 for the latest). Jobs created
+// before attempts were recorded fall back to the job's own resource path.
+func (s *GatewayService) resolveLogTarget(ctx context.Context, tenantId, jobId string, attemptNumber int64) (*logTarget, error) {
+	if attemptNumber < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("attempt_number must not be negative"))
+	}
+
+	job, err := s.dbClient.GetJob(ctx, tenantId, jobId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", jobId))
+		}
+		log.Printf("Failed to get job %s: %v", jobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+	}
+
+	attempts, err := s.dbClient.ListJobAttempts(ctx, tenantId, job.JobId)
+	if err != nil {
+		log.Printf("Failed to list attempts for job %s: %v", job.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list job attempts: %w", err))
+	}
+
+	if len(attempts) == 0 {
+		if attemptNumber > 1 {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("attempt %d of job %s not found", attemptNumber, jobId))
+		}
+		target := &logTarget{attemptNumber: 1, finished: database.IsTerminalStatus(job.Status)}
+		if job.CloudJobResourcePath != nil {
+			target.cloudResourcePath = *job.CloudJobResourcePath
+		}
+		return target, nil
+	}
+
+	attempt := attempts[len(attempts)-1]
+	if attemptNumber != 0 {
+		attempt = nil
+		for _, a := range attempts {
+			if a.AttemptNumber == attemptNumber {
+				attempt = a
+				break
+			}
+		}
+		if attempt == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("attempt %d of job %s not found", attemptNumber, jobId))
+		}
+	}
+
+	target := &logTarget{
+		attemptNumber: attempt.AttemptNumber,
+		finished:      database.IsTerminalStatus(attempt.Status) || attempt.EndedAt != nil,
+	}
+	if attempt.CloudJobResourcePath != nil {
+		target.cloudResourcePath = *attempt.CloudJobResourcePath
+	}
+	if attempt.ProviderJobUid != nil {
+		target.providerJobUID = *attempt.ProviderJobUid
+	}
+	return target, nil
+}
+
+// parseSince parses an optional RFC 3339 "since" field.
+func parseSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid since %q: must be RFC 3339", since)
+	}
+	return t, nil
+}
+
+func logEntryToProto(e batch.LogEntry) *jennahv1.LogEntry {
+	return &jennahv1.LogEntry{
+		Timestamp: e.Timestamp.UTC().Format(time.RFC3339Nano),
+		Severity:  e.Severity,
+		Message:   e.Message,
+	}
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextLogPollInterval(t *testing.T) {
+	tests := []struct {
+		last  time.Duration
+		reset bool
+		want  time.Duration
+	}{
+		{logPollInterval, false, 2 * logPollInterval},
+		{2 * logPollInterval, false, 4 * logPollInterval},
+		{4 * logPollInterval, false, logMaxPollInterval},
+		{logMaxPollInterval, false, logMaxPollInterval},
+		{logMaxPollInterval, true, logPollInterval},
+	}
+	for _, tt := range tests {
+		if got := nextLogPollInterval(tt.last, tt.reset); got != tt.want {
+			t.Errorf("nextLogPollInterval(%s, %v) = %s, want %s", tt.last, tt.reset, got, tt.want)
+		}
+	}
+}
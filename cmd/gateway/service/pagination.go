@@ -0,0 +1,110 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/batch"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// jobFilterFromRequest validates the ListJobs filters and page size.
+func jobFilterFromRequest(req *jennahv1.ListJobsRequest) (database.JobFilter, int, error) {
+	var filter database.JobFilter
+
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize < 0:
+		return filter, 0, errors.New("page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	for _, status := range req.Statuses {
+		status = strings.ToUpper(strings.TrimSpace(status))
+		if !database.IsJobStatus(status) {
+			return filter, 0, fmt.Errorf("unknown status %q", status)
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	if req.CreatedAfter != "" {
+		t, err := time.Parse(time.RFC3339, req.CreatedAfter)
+		if err != nil {
+			return filter, 0, fmt.Errorf("created_after must be an RFC 3339 time: %w", err)
+		}
+		filter.CreatedAfter = t
+	}
+	if req.CreatedBefore != "" {
+		t, err := time.Parse(time.RFC3339, req.CreatedBefore)
+		if err != nil {
+			return filter, 0, fmt.Errorf("created_before must be an RFC 3339 time: %w", err)
+		}
+		filter.CreatedBefore = t
+	}
+	filter.ImageURIPrefix = req.ImageUriPrefix
+
+	for _, selectors := range req.LabelSelectors {
+		for _, selector := range strings.Split(selectors, ",") {
+			key, value, ok := strings.Cut(strings.TrimSpace(selector), "=")
+			if !ok {
+				return filter, 0, fmt.Errorf("label selector %q must be key=value", selector)
+			}
+			if err := batch.ValidateLabelKey(key); err != nil {
+				return filter, 0, err
+			}
+			if err := batch.ValidateLabelValue(key, value); err != nil {
+				return filter, 0, err
+			}
+			if filter.Labels == nil {
+				filter.Labels = make(map[string]string)
+			}
+			if prev, seen := filter.Labels[key]; seen && prev != value {
+				return filter, 0, fmt.Errorf("label selectors require %s to be both %q and %q", key, prev, value)
+			}
+			filter.Labels[key] = value
+		}
+	}
+
+	return filter, pageSize, nil
+}
+
+// encodePageToken packs a ListJobs cursor into an opaque token.
+func encodePageToken(cursor *database.JobCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.JobID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodePageToken reverses encodePageToken. An empty token means the first page.
+func decodePageToken(token string) (*database.JobCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("invalid page_token")
+	}
+	ts, jobID, ok := strings.Cut(string(raw), "|")
+	if !ok || jobID == "" {
+		return nil, errors.New("invalid page_token")
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, errors.New("invalid page_token")
+	}
+	return &database.JobCursor{CreatedAt: createdAt, JobID: jobID}, nil
+}
@@ -1,9 +1,12 @@
 package service
 
 import (
+	"fmt"
+	"net/http"
 	"sync"
 
 	jennahv1connect "github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+	"github.com/alphauslabs/jennah/internal/batch"
 	"github.com/alphauslabs/jennah/internal/database"
 	"github.com/alphauslabs/jennah/internal/hashing"
 )
@@ -13,6 +16,7 @@ type GatewayService struct {
 	router        *hashing.Router
 	workerClients map[string]jennahv1connect.DeploymentServiceClient
 	dbClient      *database.Client
+	logSource     batch.LogSource // nil when job logs are not configured
 	mu            sync.RWMutex
 	oauthToTenant map[string]string
 }
@@ -21,11 +25,34 @@ func NewGatewayService(
 	router *hashing.Router,
 	workerClients map[string]jennahv1connect.DeploymentServiceClient,
 	dbClient *database.Client,
+	logSource batch.LogSource,
 ) *GatewayService {
 	return &GatewayService{
 		router:        router,
 		workerClients: workerClients,
 		dbClient:      dbClient,
+		logSource:     logSource,
 		oauthToTenant: make(map[string]string),
 	}
 }
+
+// workerFor picks the worker for routingKey using the consistent hashing router.
+func (s *GatewayService) workerFor(routingKey string) (string, jennahv1connect.DeploymentServiceClient, error) {
+	workerIP := s.router.GetWorkerIP(routingKey)
+	if workerIP == "" {
+		return "", nil, fmt.Errorf("no worker found for routing key %s", routingKey)
+	}
+	workerClient, exists := s.workerClients[workerIP]
+	if !exists {
+		return "", nil, fmt.Errorf("no worker client found for IP %s", workerIP)
+	}
+	return workerIP, workerClient, nil
+}
+
+// setWorkerHeaders passes the resolved tenant and user info on to a worker request.
+func setWorkerHeaders(header http.Header, tenantId string, oauthUser *OAuthUser) {
+	header.Set("X-Tenant-Id", tenantId)
+	header.Set("X-User-Email", oauthUser.Email)
+	header.Set("X-OAuth-Provider", oauthUser.Provider)
+	header.Set("X-OAuth-User-Id", oauthUser.UserId)
+}
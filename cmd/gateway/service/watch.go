@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// watchPollInterval is how often WatchJobs checks for new transitions.
+const watchPollInterval = time.Second
+
+func (s *GatewayService) WatchJobs(
+	ctx context.Context,
+	req *connect.Request[jennahv1.WatchJobsRequest],
+	stream *connect.ServerStream[jennahv1.WatchJobsResponse],
+) error {
+	log.Printf("Received watch jobs request")
+
+	oauthUser, err := extractOAuthUser(req.Header())
+	if err != nil {
+		log.Printf("OAuth authentication failed: %v", err)
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	tenantId, err := s.getOrCreateTenant(oauthUser)
+	if err != nil {
+		log.Printf("Failed to get or create tenant: %v", err)
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	jobId := req.Msg.JobId
+	var afterTime time.Time
+	var afterId string
+	if req.Msg.ResumeToken != "" {
+		afterTime, afterId, err = decodeResumeToken(req.Msg.ResumeToken)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	} else if jobId == "" {
+		// A tenant-wide watch without a token only sees changes from now on,
+		// as Spanner sees it, since the cursor compares commit timestamps.
+		afterTime, err = s.dbClient.CurrentTimestamp(ctx)
+		if err != nil {
+			log.Printf("Failed to read current timestamp: %v", err)
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to watch jobs: %w", err))
+		}
+	}
+
+	var job *database.Job
+	if jobId != "" {
+		job, err = s.dbClient.GetJob(ctx, tenantId, jobId)
+		if err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", jobId))
+			}
+			log.Printf("Failed to get job %s: %v", jobId, err)
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+		}
+	}
+
+	log.Printf("Watching jobs for tenant %s (job: %q)", tenantId, jobId)
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		transitions, err := s.dbClient.ListTransitionsAfter(ctx, tenantId, jobId, afterTime, afterId)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("Failed to list transitions for tenant %s: %v", tenantId, err)
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to watch jobs: %w", err))
+		}
+
+		for _, t := range transitions {
+			afterTime, afterId = t.TransitionedAt, t.TransitionId
+			if err := stream.Send(&jennahv1.WatchJobsResponse{
+				JobId:       t.JobId,
+				Transition:  transitionToProto(t),
+				ResumeToken: encodeResumeToken(afterTime, afterId),
+			}); err != nil {
+				log.Printf("Watch stream for tenant %s closed: %v", tenantId, err)
+				return nil
+			}
+		}
+		// Judge the end of a single-job stream by the last transition of the
+		// batch: a retry writes FAILED and FAILED → PENDING in the same commit.
+		if jobId != "" && len(transitions) > 0 && database.IsTerminalStatus(transitions[len(transitions)-1].ToStatus) {
+			return nil
+		}
+
+		// A single job that is already finished has nothing more to send, and one
+		// that was deleted mid-watch would otherwise keep the stream open forever.
+		if jobId != "" && len(transitions) == 0 {
+			job, err = s.dbClient.GetJob(ctx, tenantId, jobId)
+			if err != nil {
+				if spanner.ErrCode(err) == codes.NotFound {
+					return connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", jobId))
+				}
+				if ctx.Err() != nil {
+					return nil
+				}
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+			}
+			if database.IsTerminalStatus(job.Status) {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// encodeResumeToken packs a transition's position in the stream into an opaque token.
+func encodeResumeToken(transitionedAt time.Time, transitionId string) string {
+	raw := transitionedAt.UTC().Format(time.RFC3339Nano) + "|" + transitionId
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeResumeToken reverses encodeResumeToken.
+func decodeResumeToken(token string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", errors.New("invalid resume_token")
+	}
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return time.Time{}, "", errors.New("invalid resume_token")
+	}
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return time.Time{}, "", errors.New("invalid resume_token")
+	}
+	return t, id, nil
+}
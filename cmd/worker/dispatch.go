@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alphauslabs/jennah/internal/batch"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/secrets"
+)
+
+// errSecretsDisabled is returned for jobs with secret env vars when the
+// worker has no secret resolver configured.
+var errSecretsDisabled = errors.New("secret env vars are not enabled on this worker")
+
+// dispatcher submits job attempts to the batch provider and decides whether a
+// failed attempt is retried. It is shared by WorkerServer, for first attempts,
+// and Reconciler, for runtime failures and due retries.
+type dispatcher struct {
+	dbClient      *database.Client
+	batchProvider batch.Provider
+	secrets       secrets.Resolver
+}
+
+// dispatch submits attempt retryCount+1 of a job to the batch provider and
+// records it. preemptions is how many earlier attempts were preempted. If the
+// provider rejects the job, the failure goes through failOrRetry and dispatch
+// reports PENDING when a retry was scheduled.
+func (d dispatcher) dispatch(ctx context.Context, tenantId, jobID string, spec *database.JobSpec, retryCount, preemptions int64) (string, error) {
+	// Each attempt needs its own provider job name.
+	providerJobID := generateProviderJobID(jobID)
+	if retryCount > 0 {
+		providerJobID = fmt.Sprintf("%s-r%d", providerJobID, retryCount)
+	}
+	log.Printf("Submitting attempt %d of job %s as provider job %s", retryCount+1, jobID, providerJobID)
+
+	batchJobConfig := batch.JobConfig{
+		JobID:      providerJobID,
+		ImageURI:   spec.ImageURI,
+		EnvVars:    spec.EnvVars,
+		Commands:   spec.Commands,
+		Entrypoint: spec.Entrypoint,
+		Resources: &batch.ResourceRequirements{
+			CPUMillis:             spec.Resources.CPUMillis,
+			MemoryMiB:             spec.Resources.MemoryMiB,
+			MaxRunDurationSeconds: spec.Resources.MaxRunDurationSeconds,
+		},
+		MaxTaskRetries: spec.MaxTaskRetries,
+		TaskCount:      spec.TaskCount,
+		Parallelism:    spec.Parallelism,
+		Priority:       spec.Priority,
+	}
+	if a := spec.Allocation; a != nil {
+		batchJobConfig.Allocation = &batch.AllocationPolicy{
+			MachineType:       a.MachineType,
+			ProvisioningModel: batch.ProvisioningModel(a.ProvisioningModel),
+			Zones:             a.Zones,
+		}
+		if retries, fallback := preemptionBudget(spec); fallback && preemptions > retries {
+			log.Printf("Job %s was preempted %d times, falling back to standard VMs", jobID, preemptions)
+			batchJobConfig.Allocation.ProvisioningModel = batch.ProvisioningModelStandard
+		}
+	}
+	if sc := spec.Script; sc != nil {
+		batchJobConfig.Script = &batch.Script{Text: sc.Text, Interpreter: sc.Interpreter}
+	}
+	if o := spec.ContainerOptions; o != nil {
+		batchJobConfig.WorkingDir = o.WorkingDir
+		batchJobConfig.User = o.User
+	}
+	for _, step := range spec.Steps {
+		batchJobConfig.Steps = append(batchJobConfig.Steps, batchStep(step))
+	}
+	// System labels attribute the provider job, e.g. in billing exports.
+	batchJobConfig.Labels = map[string]string{
+		batch.LabelTenantID: tenantId,
+		batch.LabelJobID:    jobID,
+	}
+	for k, v := range spec.Labels {
+		batchJobConfig.Labels[k] = v
+	}
+	for _, volume := range spec.Volumes {
+		batchJobConfig.Volumes = append(batchJobConfig.Volumes, batchVolume(volume))
+	}
+	for _, policy := range spec.LifecyclePolicies {
+		batchJobConfig.LifecyclePolicies = append(batchJobConfig.LifecyclePolicies, batch.LifecyclePolicy{
+			Action:    batch.LifecycleAction(policy.Action),
+			ExitCodes: policy.ExitCodes,
+		})
+	}
+
+	// Secrets are resolved for every attempt, so a retry picks up a secret
+	// that was created or repaired since the last one.
+	secretEnvVars, err := d.resolveSecrets(ctx, spec.SecretEnvVars)
+	var jobResult *batch.JobResult
+	if err == nil {
+		batchJobConfig.SecretEnvVars = secretEnvVars
+		jobResult, err = d.batchProvider.SubmitJob(ctx, batchJobConfig)
+	}
+	if err != nil {
+		log.Printf("Error submitting job to batch provider: %v", err)
+		retried, failErr := d.failOrRetry(ctx, tenantId, jobID, spec, retryCount, database.FailureClassSubmission, err.Error(), nil)
+		if failErr != nil {
+			log.Printf("Error recording failed submission of job %s: %v", jobID, failErr)
+			d.abandonJob(ctx, tenantId, jobID, err.Error())
+		}
+		if retried {
+			return database.JobStatusPending, nil
+		}
+		return "", fmt.Errorf("failed to submit batch job: %w", err)
+	}
+	log.Printf("Batch job created: %s", jobResult.CloudResourcePath)
+
+	// Update job status and cloud resource path based on provider's initial status
+	statusToSet := string(jobResult.InitialStatus)
+	if statusToSet == "" || statusToSet == string(batch.JobStatusUnknown) {
+		statusToSet = database.JobStatusRunning
+	}
+
+	err = d.dbClient.RecordAttemptSubmitted(ctx, tenantId, jobID, statusToSet, providerJobID, jobResult.ProviderJobUID, jobResult.CloudResourcePath)
+	if err != nil {
+		log.Printf("Error updating job status to %s: %v", statusToSet, err)
+		err = fmt.Errorf("failed to update job status: %w", err)
+		// Nothing references the provider job now (typically the job was
+		// cancelled meanwhile), so cancel it rather than leave it running.
+		if cancelErr := d.batchProvider.CancelJob(ctx, jobResult.CloudResourcePath); cancelErr != nil {
+			log.Printf("Error cancelling orphaned batch job %s: %v", jobResult.CloudResourcePath, cancelErr)
+			err = fmt.Errorf("%w (and failed to cancel batch job %s: %v)", err, jobResult.CloudResourcePath, cancelErr)
+		} else {
+			log.Printf("Cancelled orphaned batch job %s", jobResult.CloudResourcePath)
+		}
+		d.abandonJob(ctx, tenantId, jobID, err.Error())
+		return "", err
+	}
+	log.Printf("Job %s status updated to %s with cloud path: %s", jobID, statusToSet, jobResult.CloudResourcePath)
+
+	return statusToSet, nil
+}
+
+// abandonJob fails a job whose attempt could not be recorded, when nothing
+// would pick it up again: it is not terminal, has no provider job for the
+// reconciler to poll and no retry scheduled. Jobs the reconciler still owns,
+// or that were cancelled meanwhile, are left alone.
+func (d dispatcher) abandonJob(ctx context.Context, tenantId, jobID, errorMessage string) {
+	job, err := d.dbClient.GetJob(ctx, tenantId, jobID)
+	if err != nil {
+		log.Printf("Error reading job %s to abandon it: %v", jobID, err)
+		return
+	}
+	if database.IsTerminalStatus(job.Status) || job.NextAttemptAt != nil ||
+		(job.CloudJobResourcePath != nil && *job.CloudJobResourcePath != "") {
+		return
+	}
+	if err := d.dbClient.FailJob(ctx, tenantId, jobID, database.FailureClassSubmission, errorMessage, nil); err != nil {
+		log.Printf("Error failing abandoned job %s: %v", jobID, err)
+		return
+	}
+	log.Printf("Job %s failed: its attempt could not be recorded", jobID)
+}
+
+// resolveSecrets turns a job's secret references into provider references.
+// Only references are logged or returned, never secret values.
+func (d dispatcher) resolveSecrets(ctx context.Context, refs map[string]database.SecretRef) (map[string]string, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	if d.secrets == nil {
+		return nil, errSecretsDisabled
+	}
+	resolved := make(map[string]string, len(refs))
+	for name, ref := range refs {
+		value, err := d.secrets.Resolve(ctx, secrets.Ref{Name: ref.Secret, Version: ref.Version})
+		if err != nil {
+			return nil, fmt.Errorf("secret env var %s: %w", name, err)
+		}
+		resolved[name] = value
+	}
+	return resolved, nil
+}
+
+// failOrRetry records a failed attempt. When the job's retry policy covers
+// failureClass and retries remain, the job goes back to PENDING with its next
+// attempt scheduled after the policy's backoff and failOrRetry reports true.
+// Otherwise the job is marked FAILED. outcome is recorded when known.
+func (d dispatcher) failOrRetry(ctx context.Context, tenantId, jobID string, spec *database.JobSpec, retryCount int64, failureClass, errorMessage string, outcome *database.JobOutcome) (bool, error) {
+	if spec != nil && spec.RetryPolicy != nil && spec.RetryPolicy.RetriesOn(failureClass) {
+		nextAttemptAt := time.Now().Add(spec.RetryPolicy.Backoff(retryCount + 1))
+		err := d.dbClient.RetryJob(ctx, tenantId, jobID, failureClass, errorMessage, outcome, nextAttemptAt)
+		if err == nil {
+			log.Printf("Job %s failed (%s), retry %d scheduled for %s", jobID, failureClass, retryCount+1, nextAttemptAt.Format(time.RFC3339))
+			return true, nil
+		}
+		if !errors.Is(err, database.ErrRetriesExhausted) {
+			return false, err
+		}
+	}
+	return false, d.dbClient.FailJob(ctx, tenantId, jobID, failureClass, errorMessage, outcome)
+}
+
+// preemptOrFail handles a job whose VMs the provider reclaimed. Within the
+// job's preemption budget the attempt is closed as PREEMPTED and the job is
+// resubmitted right away; after that the preemption is treated like any other
+// runtime failure by failOrRetry. It reports whether the job was requeued.
+func (d dispatcher) preemptOrFail(ctx context.Context, job *database.Job, spec *database.JobSpec, outcome *database.JobOutcome) (bool, error) {
+	retries, fallback := preemptionBudget(spec)
+	budget := retries
+	if fallback {
+		budget++
+	}
+
+	message := failureMessage(outcome)
+	err := d.dbClient.PreemptJob(ctx, job.TenantId, job.JobId, message, outcome, time.Now(), budget)
+	if err == nil {
+		log.Printf("Job %s was preempted, resubmission %d of %d scheduled", job.JobId, job.PreemptionCount+1, budget)
+		return true, nil
+	}
+	if !errors.Is(err, database.ErrPreemptionsExhausted) {
+		return false, err
+	}
+	return d.failOrRetry(ctx, job.TenantId, job.JobId, spec, job.RetryCount, database.FailureClassRuntime, message, outcome)
+}
+
+// preemptionBudget returns how many times a preempted job is resubmitted as
+// submitted, and whether one more attempt on STANDARD VMs follows.
+func preemptionBudget(spec *database.JobSpec) (int64, bool) {
+	if spec == nil || spec.PreemptionPolicy == nil {
+		return defaultPreemptionRetries, false
+	}
+	fallback := spec.PreemptionPolicy.FallbackToStandard &&
+		spec.Allocation != nil && spec.Allocation.ProvisioningModel == string(batch.ProvisioningModelSpot)
+	return spec.PreemptionPolicy.MaxRetries, fallback
+}
+
+// batchStep converts a stored job step into its provider form.
+func batchStep(step database.Step) batch.Step {
+	result := batch.Step{
+		Name:             step.Name,
+		ImageURI:         step.ImageURI,
+		Commands:         step.Commands,
+		Entrypoint:       step.Entrypoint,
+		Barrier:          step.Barrier,
+		EnvVars:          step.EnvVars,
+		Background:       step.Background,
+		IgnoreExitStatus: step.IgnoreExitStatus,
+	}
+	if o := step.ContainerOptions; o != nil {
+		result.WorkingDir = o.WorkingDir
+		result.User = o.User
+	}
+	if sc := step.Script; sc != nil {
+		result.Script = &batch.Script{Text: sc.Text, Interpreter: sc.Interpreter}
+	}
+	return result
+}
+
+// batchVolume converts a stored job volume into its provider form.
+func batchVolume(volume database.Volume) batch.Volume {
+	result := batch.Volume{MountPath: volume.MountPath, ReadOnly: volume.ReadOnly}
+	if g := volume.GCS; g != nil {
+		result.GCS = &batch.GCSVolume{Bucket: g.Bucket, Path: g.Path}
+	}
+	if n := volume.NFS; n != nil {
+		result.NFS = &batch.NFSVolume{Server: n.Server, RemotePath: n.RemotePath}
+	}
+	if d := volume.Disk; d != nil {
+		result.Disk = &batch.DiskVolume{SizeGB: d.SizeGB, Type: d.Type}
+	}
+	return result
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/batch"
+	"github.com/alphauslabs/jennah/internal/batch/fake"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// unrecordableProvider reports every submitted job in a status the job
+// lifecycle does not know, so recording the attempt fails after the provider
+// accepted it.
+type unrecordableProvider struct {
+	*fake.FakeBatchProvider
+}
+
+func (p unrecordableProvider) SubmitJob(ctx context.Context, config batch.JobConfig) (*batch.JobResult, error) {
+	result, err := p.FakeBatchProvider.SubmitJob(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	result.InitialStatus = "BOGUS"
+	return result, nil
+}
+
+// providerJobStatus returns the status of the provider job whose path ends in
+// suffix.
+func (w *testWorker) providerJobStatus(t *testing.T, suffix string) batch.JobStatus {
+	t.Helper()
+	ctx := context.Background()
+	paths, err := w.provider.ListJobs(ctx)
+	if err != nil {
+		t.Fatalf("ListJobs: %v", err)
+	}
+	for _, path := range paths {
+		if strings.HasSuffix(path, suffix) {
+			info, err := w.provider.GetJobStatus(ctx, path)
+			if err != nil {
+				t.Fatalf("GetJobStatus: %v", err)
+			}
+			return info.Status
+		}
+	}
+	t.Fatalf("provider jobs %v, want one ending in %s", paths, suffix)
+	return ""
+}
+
+func TestDispatchCancelsOrphanedProviderJob(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWorker(t, "")
+	resp, err := w.submit(t, &jennahv1.SubmitJobRequest{ImageUri: "busybox"})
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+	job, err := w.db.GetJob(ctx, testTenant, resp.JobId)
+	if err != nil {
+		t.Fatalf("GetJob: %v", err)
+	}
+	spec, err := job.Spec()
+	if err != nil {
+		t.Fatalf("Spec: %v", err)
+	}
+	// Cancelling only the record leaves nothing able to accept a new attempt.
+	if err := w.db.CancelJob(ctx, testTenant, resp.JobId, "cancelled by test"); err != nil {
+		t.Fatalf("CancelJob: %v", err)
+	}
+
+	if _, err := w.server.dispatcher().dispatch(ctx, testTenant, resp.JobId, spec, 1, 0); err == nil {
+		t.Fatal("dispatch succeeded for a cancelled job")
+	}
+
+	if status := w.providerJobStatus(t, "-r1"); status != batch.JobStatusCancelled {
+		t.Errorf("orphaned provider job status = %s, want %s", status, batch.JobStatusCancelled)
+	}
+	job, err = w.db.GetJob(ctx, testTenant, resp.JobId)
+	if err != nil {
+		t.Fatalf("GetJob: %v", err)
+	}
+	if job.Status != database.JobStatusCancelled {
+		t.Errorf("status = %s, want %s", job.Status, database.JobStatusCancelled)
+	}
+}
+
+func TestSubmitJobFailsJobWhoseAttemptIsNotRecorded(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWorker(t, "")
+	w.server.batchProvider = unrecordableProvider{w.provider}
+
+	if _, err := w.submit(t, &jennahv1.SubmitJobRequest{ImageUri: "busybox"}); err == nil {
+		t.Fatal("SubmitJob succeeded although its attempt was not recorded")
+	}
+
+	jobs, err := w.db.ListJobs(ctx, testTenant)
+	if err != nil {
+		t.Fatalf("ListJobs: %v", err)
+	}
+	if len(jobs) != 1 {
+		t.Fatalf("got %d jobs, want 1", len(jobs))
+	}
+	job := jobs[0]
+	if job.Status != database.JobStatusFailed {
+		t.Fatalf("status = %s, want %s", job.Status, database.JobStatusFailed)
+	}
+	if job.ErrorMessage == nil || !strings.Contains(*job.ErrorMessage, "failed to update job status") {
+		t.Errorf("error message = %v, want the recording error", job.ErrorMessage)
+	}
+	if status := w.providerJobStatus(t, generateProviderJobID(job.JobId)); status != batch.JobStatusCancelled {
+		t.Errorf("provider job status = %s, want %s", status, batch.JobStatusCancelled)
+	}
+}
+
+func TestDispatchLeavesScheduledRetryToReconciler(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWorker(t, "")
+	resp, err := w.submit(t, &jennahv1.SubmitJobRequest{
+		ImageUri:    "busybox",
+		RetryPolicy: &jennahv1.RetryPolicy{MaxAttempts: 2},
+	})
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+	job, err := w.db.GetJob(ctx, testTenant, resp.JobId)
+	if err != nil {
+		t.Fatalf("GetJob: %v", err)
+	}
+	spec, err := job.Spec()
+	if err != nil {
+		t.Fatalf("Spec: %v", err)
+	}
+	if err := w.db.RetryJob(ctx, testTenant, resp.JobId, database.FailureClassRuntime, "boom", nil, time.Now()); err != nil {
+		t.Fatalf("RetryJob: %v", err)
+	}
+
+	w.server.batchProvider = unrecordableProvider{w.provider}
+	if _, err := w.server.dispatcher().dispatch(ctx, testTenant, resp.JobId, spec, 1, 0); err == nil {
+		t.Fatal("dispatch succeeded although its attempt was not recorded")
+	}
+
+	job, err = w.db.GetJob(ctx, testTenant, resp.JobId)
+	if err != nil {
+		t.Fatalf("GetJob: %v", err)
+	}
+	if job.Status != database.JobStatusPending || job.NextAttemptAt == nil {
+		t.Errorf("status = %s, next attempt %v; want PENDING with the retry still scheduled", job.Status, job.NextAttemptAt)
+	}
+}
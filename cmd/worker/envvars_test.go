@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/envelope"
+)
+
+// setTestKEK enables env var encryption on the worker's database client.
+func setTestKEK(t *testing.T, w *testWorker) {
+	t.Helper()
+	key, err := envelope.NewDataKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "kek")
+	if err := os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	kek, err := envelope.LoadKeyfileKEK(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.db.SetKeyEncryptionKey(kek)
+}
+
+func TestEnvVarsEncryptedAtRest(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWorker(t, "")
+	setTestKEK(t, w)
+
+	resp, err := w.submit(t, &jennahv1.SubmitJobRequest{ImageUri: "busybox", EnvVars: map[string]string{"TOKEN": "s3cret"}})
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+	if got := w.submittedConfig(t, resp.JobId).EnvVars["TOKEN"]; got != "s3cret" {
+		t.Errorf("provider env var TOKEN = %q, want s3cret", got)
+	}
+
+	job, err := w.db.GetJob(ctx, testTenant, resp.JobId)
+	if err != nil {
+		t.Fatalf("GetJob: %v", err)
+	}
+	stored, err := json.Marshal(job.JobSpec.Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(stored), "s3cret") {
+		t.Errorf("stored spec contains the env var value: %s", stored)
+	}
+	if spec, err := job.Spec(); err != nil || !spec.EnvVarsEncrypted {
+		t.Errorf("stored spec is not marked encrypted (err %v)", err)
+	}
+	spec, err := w.db.DecryptedSpec(ctx, job)
+	if err != nil {
+		t.Fatalf("DecryptedSpec: %v", err)
+	}
+	if got := spec.EnvVars["TOKEN"]; got != "s3cret" {
+		t.Errorf("DecryptedSpec env var TOKEN = %q, want s3cret", got)
+	}
+
+	// A rerun resubmits the decrypted values.
+	rerun, err := w.server.RerunJob(ctx, tenantRequest(&jennahv1.RerunJobRequest{JobId: resp.JobId}))
+	if err != nil {
+		t.Fatalf("RerunJob: %v", err)
+	}
+	if got := w.submittedConfig(t, rerun.Msg.JobId).EnvVars["TOKEN"]; got != "s3cret" {
+		t.Errorf("rerun provider env var TOKEN = %q, want s3cret", got)
+	}
+}
+
+func TestRerunEncryptedJobWithoutKEK(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWorker(t, "")
+	setTestKEK(t, w)
+	resp, err := w.submit(t, &jennahv1.SubmitJobRequest{ImageUri: "busybox", EnvVars: map[string]string{"TOKEN": "s3cret"}})
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+
+	w.db.SetKeyEncryptionKey(nil)
+	_, err = w.server.RerunJob(ctx, tenantRequest(&jennahv1.RerunJobRequest{JobId: resp.JobId}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("RerunJob without KEK: %v, want failed_precondition", err)
+	}
+}
@@ -12,9 +12,12 @@ import (
 
 	"github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
 	"github.com/alphauslabs/jennah/internal/batch"
-	_ "github.com/alphauslabs/jennah/internal/batch/gcp" // Register GCP provider
+	_ "github.com/alphauslabs/jennah/internal/batch/fake" // Register fake provider
+	_ "github.com/alphauslabs/jennah/internal/batch/gcp"  // Register GCP provider
 	"github.com/alphauslabs/jennah/internal/config"
 	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/envelope"
+	"github.com/alphauslabs/jennah/internal/secrets"
 )
 
 func main() {
@@ -39,6 +42,18 @@ func main() {
 	log.Printf("Connected to database: %s/%s/%s", 
 		cfg.Database.ProjectID, cfg.Database.Instance, cfg.Database.Database)
 
+	// Encrypt stored env var values when a key-encryption key is configured
+	kek, err := envelope.NewKEK(cfg.Encryption)
+	if err != nil {
+		log.Fatalf("Failed to load key encryption key: %v", err)
+	}
+	if kek != nil {
+		dbClient.SetKeyEncryptionKey(kek)
+		log.Printf("Env var encryption enabled with KEK %s", kek.ID())
+	} else {
+		log.Printf("Warning: ENV_KEK_PROVIDER is not set, env vars are stored unencrypted")
+	}
+
 	// Initialize batch provider
 	batchProvider, err := batch.NewProvider(ctx, cfg.BatchProvider)
 	if err != nil {
@@ -47,6 +62,15 @@ func main() {
 	log.Printf("Initialized %s batch provider in region: %s", 
 		cfg.BatchProvider.Provider, cfg.BatchProvider.Region)
 
+	// Initialize secret resolver (nil when secret env vars are disabled)
+	secretResolver, err := secrets.NewResolver(cfg.Secrets)
+	if err != nil {
+		log.Fatalf("Failed to create secret resolver: %v", err)
+	}
+	if secretResolver != nil {
+		log.Printf("Initialized %s secret resolver", cfg.Secrets.Provider)
+	}
+
 	// Load job configuration from JSON file
 	jobConfigPath := os.Getenv("JOB_CONFIG_PATH")
 	if jobConfigPath == "" {
@@ -65,7 +89,9 @@ func main() {
 	workerServer := &WorkerServer{
 		dbClient:      dbClient,
 		batchProvider: batchProvider,
+		secrets:       secretResolver,
 		jobConfig:     jobConfig,
+		requestIDTTL:  cfg.RequestIDTTL,
 	}
 
 	mux := http.NewServeMux()
@@ -88,11 +114,21 @@ func main() {
 	sigCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	// Keep job statuses in sync with the batch provider in the background
+	reconciler := NewReconciler(dbClient, batchProvider, secretResolver, cfg.Reconciler.Interval, cfg.Reconciler.Concurrency)
+	reconcilerDone := make(chan struct{})
+	go func() {
+		defer close(reconcilerDone)
+		reconciler.Run(sigCtx)
+	}()
+
 	go func() {
 		log.Printf("Worker listening on %s", addr)
 		log.Println("Available endpoints:")
 		log.Printf("  • POST %sSubmitJob", path)
+		log.Printf("  • POST %sRerunJob", path)
 		log.Printf("  • POST %sListJobs", path)
+		log.Printf("  • POST %sCancelJob", path)
 		log.Printf("  • GET  /health")
 		log.Printf("Worker configured for provider: %s, region: %s", 
 			cfg.BatchProvider.Provider, cfg.BatchProvider.Region)
@@ -112,6 +148,7 @@ func main() {
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		log.Printf("Error during server shutdown: %v", err)
 	}
+	<-reconcilerDone
 
 	log.Println("Worker stopped")
 }
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/alphauslabs/jennah/internal/batch"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/secrets"
+)
+
+// jobPollTimeout bounds a single provider status lookup plus the database update.
+const jobPollTimeout = 30 * time.Second
+
+// retryClaimLease is how long a claimed retry stays reserved for the worker
+// that claimed it. If that worker dies before submitting, another worker picks
+// the retry up once the lease expires.
+const retryClaimLease = 5 * time.Minute
+
+// statusRank orders job statuses so the reconciler only ever moves a job forward.
+var statusRank = map[string]int{
+	database.JobStatusPending:   0,
+	database.JobStatusScheduled: 1,
+	database.JobStatusRunning:   2,
+	database.JobStatusCompleted: 3,
+	database.JobStatusFailed:    3,
+	database.JobStatusCancelled: 3,
+}
+
+// Reconciler keeps Jobs rows in sync with the batch provider.
+// On every pass it polls each non-terminal job that has a CloudJobResourcePath
+// and applies the matching database status helper, then submits any retries
+// that have come due.
+type Reconciler struct {
+	dbClient      *database.Client
+	batchProvider batch.Provider
+	dispatcher    dispatcher
+	interval      time.Duration
+	concurrency   int
+}
+
+// NewReconciler creates a reconciler that polls every interval (±20% jitter)
+// with at most concurrency provider calls in flight.
+func NewReconciler(dbClient *database.Client, batchProvider batch.Provider, secretResolver secrets.Resolver, interval time.Duration, concurrency int) *Reconciler {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Reconciler{
+		dbClient:      dbClient,
+		batchProvider: batchProvider,
+		dispatcher:    dispatcher{dbClient: dbClient, batchProvider: batchProvider, secrets: secretResolver},
+		interval:      interval,
+		concurrency:   concurrency,
+	}
+}
+
+// Run reconciles until ctx is cancelled.
+func (r *Reconciler) Run(ctx context.Context) {
+	log.Printf("Reconciler started: interval=%s, concurrency=%d", r.interval, r.concurrency)
+
+	timer := time.NewTimer(r.nextDelay())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Reconciler stopped")
+			return
+		case <-timer.C:
+			r.reconcileOnce(ctx)
+			timer.Reset(r.nextDelay())
+		}
+	}
+}
+
+// nextDelay returns the base interval jittered by up to ±20%.
+func (r *Reconciler) nextDelay() time.Duration {
+	jitter := 0.8 + 0.4*rand.Float64()
+	return time.Duration(float64(r.interval) * jitter)
+}
+
+// reconcileOnce polls every active job once and then submits due retries,
+// each bounded by r.concurrency.
+func (r *Reconciler) reconcileOnce(ctx context.Context) {
+	jobs, err := r.dbClient.ListActiveJobs(ctx)
+	if err != nil {
+		log.Printf("Reconciler: failed to list active jobs: %v", err)
+	} else {
+		r.forEach(ctx, jobs, r.reconcileJob)
+	}
+
+	retries, err := r.dbClient.ListRetriesDue(ctx, time.Now())
+	if err != nil {
+		log.Printf("Reconciler: failed to list due retries: %v", err)
+		return
+	}
+	r.forEach(ctx, retries, r.retryJob)
+}
+
+// forEach runs fn for every job with at most r.concurrency calls in flight.
+func (r *Reconciler) forEach(ctx context.Context, jobs []*database.Job, fn func(context.Context, *database.Job)) {
+	sem := make(chan struct{}, r.concurrency)
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(job *database.Job) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+// reconcileJob fetches the provider status for one job and moves its row forward.
+func (r *Reconciler) reconcileJob(ctx context.Context, job *database.Job) {
+	if job.CloudJobResourcePath == nil || *job.CloudJobResourcePath == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, jobPollTimeout)
+	defer cancel()
+
+	providerStatus, err := r.batchProvider.GetJobStatus(ctx, *job.CloudJobResourcePath)
+	if err != nil {
+		log.Printf("Reconciler: failed to get status for job %s: %v", job.JobId, err)
+		return
+	}
+
+	next := string(providerStatus.Status)
+	outcome := outcomeFromProvider(providerStatus)
+	nextRank, known := statusRank[next]
+	if !known || next == job.Status || nextRank <= statusRank[job.Status] {
+		return
+	}
+
+	switch next {
+	case database.JobStatusScheduled:
+		err = r.dbClient.ScheduleJob(ctx, job.TenantId, job.JobId)
+	case database.JobStatusRunning:
+		err = r.dbClient.StartJob(ctx, job.TenantId, job.JobId)
+	case database.JobStatusCompleted:
+		err = r.dbClient.CompleteJob(ctx, job.TenantId, job.JobId, outcome)
+	case database.JobStatusFailed:
+		spec, specErr := job.Spec()
+		if specErr != nil {
+			// Without a readable spec there is no retry policy to honour.
+			log.Printf("Reconciler: job %s: %v", job.JobId, specErr)
+		}
+		var retried bool
+		if outcome.FailureCategory == string(batch.FailureCategoryPreempted) {
+			retried, err = r.dispatcher.preemptOrFail(ctx, job, spec, outcome)
+		} else {
+			retried, err = r.dispatcher.failOrRetry(ctx, job.TenantId, job.JobId, spec, job.RetryCount, database.FailureClassRuntime, failureMessage(outcome), outcome)
+		}
+		if retried {
+			next = database.JobStatusPending
+		}
+	case database.JobStatusCancelled:
+		err = r.dbClient.CancelJob(ctx, job.TenantId, job.JobId, "batch job cancelled")
+	}
+	if errors.Is(err, database.ErrInvalidTransition) {
+		// Another writer moved the job first; the next pass sees the new status.
+		log.Printf("Reconciler: skipped job %s: %v", job.JobId, err)
+		return
+	}
+	if err != nil {
+		log.Printf("Reconciler: failed to move job %s from %s to %s: %v", job.JobId, job.Status, next, err)
+		return
+	}
+	log.Printf("Reconciler: job %s %s → %s", job.JobId, job.Status, next)
+}
+
+// outcomeFromProvider converts a provider status report into the outcome
+// stored with a finished job.
+func outcomeFromProvider(info *batch.JobStatusInfo) *database.JobOutcome {
+	outcome := &database.JobOutcome{
+		FailureCategory: string(info.FailureCategory),
+	}
+	if info.ExitCode != nil {
+		code := int64(*info.ExitCode)
+		outcome.ExitCode = &code
+	}
+	for _, event := range info.Events {
+		outcome.Events = append(outcome.Events, database.ProviderEvent{
+			Time:        event.Time,
+			Type:        event.Type,
+			Description: event.Description,
+		})
+	}
+	return outcome
+}
+
+// failureMessage summarizes a failed job's outcome, e.g.
+// "batch job failed: OUT_OF_MEMORY (exit code 137)".
+func failureMessage(outcome *database.JobOutcome) string {
+	message := "batch job failed"
+	if outcome.FailureCategory != "" {
+		message += ": " + outcome.FailureCategory
+	}
+	if outcome.ExitCode != nil {
+		message += fmt.Sprintf(" (exit code %d)", *outcome.ExitCode)
+	}
+	return message
+}
+
+// retryJob claims a due retry and submits its next attempt. Claiming first
+// keeps two workers from submitting the same attempt.
+func (r *Reconciler) retryJob(ctx context.Context, job *database.Job) {
+	ctx, cancel := context.WithTimeout(ctx, jobPollTimeout)
+	defer cancel()
+
+	claimed, err := r.dbClient.ClaimRetry(ctx, job.TenantId, job.JobId, retryClaimLease)
+	if err != nil {
+		log.Printf("Reconciler: failed to claim retry of job %s: %v", job.JobId, err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	// The retry resubmits the job, so it needs the real env var values.
+	spec, err := r.dbClient.DecryptedSpec(ctx, job)
+	if err == nil && spec == nil {
+		err = errors.New("job has no stored spec")
+	}
+	if err != nil {
+		log.Printf("Reconciler: cannot retry job %s: %v", job.JobId, err)
+		if failErr := r.dbClient.FailJob(ctx, job.TenantId, job.JobId, database.FailureClassSubmission, "cannot retry: "+err.Error(), nil); failErr != nil {
+			log.Printf("Reconciler: failed to mark job %s failed: %v", job.JobId, failErr)
+		}
+		return
+	}
+
+	status, err := r.dispatcher.dispatch(ctx, job.TenantId, job.JobId, spec, job.RetryCount, job.PreemptionCount)
+	if err != nil {
+		log.Printf("Reconciler: retry %d of job %s failed: %v", job.RetryCount, job.JobId, err)
+		return
+	}
+	log.Printf("Reconciler: job %s retry %d submitted, now %s", job.JobId, job.RetryCount, status)
+}
@@ -8,21 +8,25 @@ import (
 	"strings"
 	"time"
 
+	"cloud.google.com/go/spanner"
 	"connectrpc.com/connect"
-	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
 
 	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
 	"github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
 	"github.com/alphauslabs/jennah/internal/batch"
 	"github.com/alphauslabs/jennah/internal/config"
 	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/secrets"
 )
 
 type WorkerServer struct {
 	jennahv1connect.UnimplementedDeploymentServiceHandler
 	dbClient      *database.Client
 	batchProvider batch.Provider
+	secrets       secrets.Resolver
 	jobConfig     *config.JobConfigFile
+	requestIDTTL  time.Duration
 }
 
 func (s *WorkerServer) SubmitJob(
@@ -37,9 +41,9 @@ func (s *WorkerServer) SubmitJob(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("X-Tenant-Id header is required"))
 	}
 
-	if req.Msg.ImageUri == "" {
-		log.Printf("Error: image_uri is empty")
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("image_uri is required"))
+	if countSet(req.Msg.ImageUri != "", req.Msg.Script != nil, len(req.Msg.Steps) > 0) != 1 {
+		log.Printf("Error: need exactly one of image_uri, script and steps")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("exactly one of image_uri, script and steps is required"))
 	}
 
 	// Ensure the tenant row exists in this worker's Spanner instance before inserting a job.
@@ -63,80 +67,206 @@ func (s *WorkerServer) SubmitJob(
 	}
 	log.Printf("Tenant %s upserted successfully", tenantId)
 
-	// Generate internal UUID for Spanner primary key
-	internalJobID := uuid.New().String()
-	log.Printf("Generated internal job ID: %s", internalJobID)
-
-	// Generate cloud provider-compatible job ID (lowercase, starts with letter, no underscores)
-	providerJobID := generateProviderJobID(internalJobID)
-	log.Printf("Generated provider job ID: %s", providerJobID)
+	request, err := s.submitRequestFromProto(tenantId, req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if request != nil {
+		existing, err := s.dbClient.GetSubmitRequest(ctx, tenantId, request.RequestId)
+		if err != nil {
+			log.Printf("Error getting request %s: %v", request.RequestId, err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if existing != nil {
+			jobID, status, err := s.replaySubmitRequest(ctx, existing, request.PayloadHash)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&jennahv1.SubmitJobResponse{JobId: jobID, Status: status}), nil
+		}
+	}
 
-	// Insert job record with PENDING status
-	err := s.dbClient.InsertJob(ctx, tenantId, internalJobID, req.Msg.ImageUri, []string{})
+	retryPolicy, err := retryPolicyFromProto(req.Msg.RetryPolicy)
 	if err != nil {
-		log.Printf("Error inserting job to database: %v", err)
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			fmt.Errorf("failed to create job record: %w", err),
-		)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	lifecyclePolicies, taskRetries, err := lifecyclePoliciesFromProto(req.Msg.LifecyclePolicies, req.Msg.MaxTaskRetries)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	containerOptions, err := containerOptionsFromProto(req.Msg.ContainerOptions)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	script, err := scriptFromProto(req.Msg.Script)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.ImageUri == "" && (len(req.Msg.Commands) > 0 || req.Msg.Entrypoint != "" || containerOptions != nil) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("commands, entrypoint and container_options only apply to image_uri jobs; set them per step instead"))
+	}
+	steps, err := stepsFromProto(req.Msg.Steps)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	taskCount, parallelism, err := taskFanOutFromProto(req.Msg.TaskCount, req.Msg.Parallelism)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	volumes, err := volumesFromProto(req.Msg.Volumes)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	labels, err := labelsFromProto(req.Msg.Labels)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	scheduling, err := schedulingFromProto(req.Msg.Priority, req.Msg.Allocation)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	secretEnvVars, err := secretEnvVarsFromProto(req.Msg.SecretEnvVars, req.Msg.EnvVars)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	log.Printf("Job %s saved to database with PENDING status", internalJobID)
 
-	// Submit job to cloud batch provider.
-	// Resolve resource requirements: named preset merged with any per-field override.
-	var resourceOverride *config.ResourceOverride
-	if o := req.Msg.ResourceOverride; o != nil {
-		resourceOverride = &config.ResourceOverride{
-			CPUMillis:             o.CpuMillis,
-			MemoryMiB:             o.MemoryMib,
-			MaxRunDurationSeconds: o.MaxRunDurationSeconds,
-		}
+	spec := s.buildJobSpec(req.Msg.ImageUri, req.Msg.EnvVars, req.Msg.ResourceProfile, overrideFromProto(req.Msg.ResourceOverride))
+	spec.Commands = req.Msg.Commands
+	spec.Entrypoint = req.Msg.Entrypoint
+	spec.ContainerOptions = containerOptions
+	spec.Script = script
+	spec.Steps = steps
+	spec.TaskCount = taskCount
+	spec.Parallelism = parallelism
+	spec.Volumes = volumes
+	spec.Labels = labels
+	spec.SecretEnvVars = secretEnvVars
+	s.applyScheduling(spec, scheduling)
+	spec.PreemptionPolicy, err = preemptionPolicyFromProto(req.Msg.PreemptionPolicy, spec.Allocation)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	spec.RetryPolicy = retryPolicy
+	spec.LifecyclePolicies = lifecyclePolicies
+	spec.MaxTaskRetries = taskRetries
+	jobID, status, err := s.submitJobSpec(ctx, tenantId, spec, "", request)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Successfully submitted job %s for tenant %s", jobID, tenantId)
+	return connect.NewResponse(&jennahv1.SubmitJobResponse{
+		JobId:  jobID, // Return internal UUID to client
+		Status: status,
+	}), nil
+}
 
-	batchJobConfig := batch.JobConfig{
-		JobID:     providerJobID,
-		ImageURI:  req.Msg.ImageUri,
-		EnvVars:   req.Msg.EnvVars,
-		Resources: s.jobConfig.ResolveResources(req.Msg.ResourceProfile, resourceOverride),
+func (s *WorkerServer) RerunJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.RerunJobRequest],
+) (*connect.Response[jennahv1.RerunJobResponse], error) {
+	tenantId := req.Header().Get("X-Tenant-Id")
+	log.Printf("Received RerunJob request for tenant: %s, job: %s", tenantId, req.Msg.JobId)
+
+	if tenantId == "" {
+		log.Printf("Error: X-Tenant-Id header is missing")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("X-Tenant-Id header is required"))
+	}
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
 	}
 
-	jobResult, err := s.batchProvider.SubmitJob(ctx, batchJobConfig)
+	source, err := s.dbClient.GetJob(ctx, tenantId, req.Msg.JobId)
 	if err != nil {
-		log.Printf("Error submitting job to batch provider: %v", err)
-		failErr := s.dbClient.FailJob(ctx, tenantId, internalJobID, err.Error())
-		if failErr != nil {
-			log.Printf("Error updating job status to FAILED: %v", failErr)
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", req.Msg.JobId))
 		}
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			fmt.Errorf("failed to submit batch job: %w", err),
-		)
+		log.Printf("Error getting job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
 	}
-	log.Printf("Batch job created: %s", jobResult.CloudResourcePath)
 
-	// Update job status and cloud resource path based on provider's initial status
-	statusToSet := string(jobResult.InitialStatus)
-	if statusToSet == "" || statusToSet == string(batch.JobStatusUnknown) {
-		statusToSet = database.JobStatusRunning
+	sourceSpec, err := s.dbClient.DecryptedSpec(ctx, source)
+	if errors.Is(err, database.ErrEncryptionDisabled) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		log.Printf("Error reading spec of job %s: %v", source.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if sourceSpec == nil {
+		// Rebuilding from the columns would silently drop env vars, so refuse instead.
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("job %s was created before job specs were stored and cannot be rerun", source.JobId))
 	}
 
-	err = s.dbClient.UpdateJobStatusAndCloudPath(ctx, tenantId, internalJobID, statusToSet, jobResult.CloudResourcePath)
+	// Overlay the requested changes on a copy of the source spec.
+	envVars := make(map[string]string, len(sourceSpec.EnvVars)+len(req.Msg.EnvVars))
+	for k, v := range sourceSpec.EnvVars {
+		envVars[k] = v
+	}
+	for k, v := range req.Msg.EnvVars {
+		if _, ok := sourceSpec.SecretEnvVars[k]; ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is a secret env var of job %s and cannot be overridden", k, source.JobId))
+		}
+		envVars[k] = v
+	}
+	labels := make(map[string]string, len(sourceSpec.Labels)+len(req.Msg.Labels))
+	for k, v := range sourceSpec.Labels {
+		labels[k] = v
+	}
+	for k, v := range req.Msg.Labels {
+		labels[k] = v
+	}
+	labels, err = labelsFromProto(labels)
 	if err != nil {
-		log.Printf("Error updating job status to %s: %v", statusToSet, err)
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			fmt.Errorf("failed to update job status: %w", err),
-		)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	profile := sourceSpec.ResourceProfile
+	override := sourceSpec.ResourceOverride
+	if req.Msg.ResourceProfile != "" {
+		profile = req.Msg.ResourceProfile
+		override = nil
+	}
+	if req.Msg.ResourceOverride != nil {
+		override = overrideFromProto(req.Msg.ResourceOverride)
 	}
-	log.Printf("Job %s status updated to %s with cloud path: %s", internalJobID, statusToSet, jobResult.CloudResourcePath)
 
-	response := connect.NewResponse(&jennahv1.SubmitJobResponse{
-		JobId:  internalJobID, // Return internal UUID to client
-		Status: statusToSet,
-	})
+	spec := s.buildJobSpec(sourceSpec.ImageURI, envVars, profile, override)
+	spec.Commands = sourceSpec.Commands
+	spec.Entrypoint = sourceSpec.Entrypoint
+	spec.ContainerOptions = sourceSpec.ContainerOptions
+	spec.Script = sourceSpec.Script
+	spec.Steps = sourceSpec.Steps
+	spec.TaskCount = sourceSpec.TaskCount
+	spec.Parallelism = sourceSpec.Parallelism
+	spec.Volumes = sourceSpec.Volumes
+	spec.Labels = labels
+	spec.SecretEnvVars = sourceSpec.SecretEnvVars
+	if req.Msg.ResourceProfile != "" {
+		// Like the resource override, the source's scheduling gives way to
+		// the new profile's.
+		s.applyScheduling(spec, config.Scheduling{})
+	} else {
+		spec.Priority = sourceSpec.Priority
+		spec.Allocation = sourceSpec.Allocation
+	}
+	spec.PreemptionPolicy = sourceSpec.PreemptionPolicy
+	spec.RetryPolicy = sourceSpec.RetryPolicy
+	spec.LifecyclePolicies = sourceSpec.LifecyclePolicies
+	spec.MaxTaskRetries = sourceSpec.MaxTaskRetries
 
-	log.Printf("Successfully submitted job %s for tenant %s", internalJobID, tenantId)
-	return response, nil
+	jobID, status, err := s.submitJobSpec(ctx, tenantId, spec, source.JobId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Successfully reran job %s as %s for tenant %s", source.JobId, jobID, tenantId)
+	return connect.NewResponse(&jennahv1.RerunJobResponse{
+		JobId:           jobID,
+		Status:          status,
+		ClonedFromJobId: source.JobId,
+	}), nil
 }
 
 // generateProviderJobID creates a provider-compatible job ID from UUID.
@@ -188,3 +318,66 @@ func (s *WorkerServer) ListJobs(
 	log.Printf("Successfully listed %d jobs for tenant %s", len(protoJobs), tenantId)
 	return response, nil
 }
+
+func (s *WorkerServer) CancelJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.CancelJobRequest],
+) (*connect.Response[jennahv1.CancelJobResponse], error) {
+	tenantId := req.Header().Get("X-Tenant-Id")
+	log.Printf("Received CancelJob request for tenant: %s, job: %s", tenantId, req.Msg.JobId)
+
+	if tenantId == "" {
+		log.Printf("Error: X-Tenant-Id header is missing")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("X-Tenant-Id header is required"))
+	}
+	if req.Msg.JobId == "" {
+		log.Printf("Error: job_id is empty")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+
+	job, err := s.dbClient.GetJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", req.Msg.JobId))
+		}
+		log.Printf("Error reading job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+	}
+
+	if database.IsTerminalStatus(job.Status) {
+		log.Printf("Job %s is already %s, refusing to cancel", job.JobId, job.Status)
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("job %s is already %s", job.JobId, job.Status),
+		)
+	}
+
+	// Jobs that never reached the provider only need their record updated.
+	if job.CloudJobResourcePath != nil && *job.CloudJobResourcePath != "" {
+		if err := s.batchProvider.CancelJob(ctx, *job.CloudJobResourcePath); err != nil {
+			log.Printf("Error cancelling batch job %s: %v", *job.CloudJobResourcePath, err)
+			return nil, connect.NewError(
+				connect.CodeInternal,
+				fmt.Errorf("failed to cancel batch job: %w", err),
+			)
+		}
+		log.Printf("Batch job cancelled: %s", *job.CloudJobResourcePath)
+	}
+
+	if err := s.dbClient.CancelJob(ctx, tenantId, job.JobId, "cancelled by user"); err != nil {
+		log.Printf("Error updating job status to CANCELLED: %v", err)
+		if errors.Is(err, database.ErrInvalidTransition) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			fmt.Errorf("failed to update job status: %w", err),
+		)
+	}
+
+	log.Printf("Successfully cancelled job %s for tenant %s", job.JobId, tenantId)
+	return connect.NewResponse(&jennahv1.CancelJobResponse{
+		JobId:  job.JobId,
+		Status: database.JobStatusCancelled,
+	}), nil
+}
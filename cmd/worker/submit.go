@@ -0,0 +1,613 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/batch"
+	"github.com/alphauslabs/jennah/internal/config"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/secrets"
+)
+
+// overrideFromProto converts an API resource override, keeping nil as nil.
+func overrideFromProto(o *jennahv1.ResourceOverride) *database.ResourceValues {
+	if o == nil {
+		return nil
+	}
+	return &database.ResourceValues{
+		CPUMillis:             o.CpuMillis,
+		MemoryMiB:             o.MemoryMib,
+		MaxRunDurationSeconds: o.MaxRunDurationSeconds,
+	}
+}
+
+// Retry policy defaults and limits.
+const (
+	maxRetryAttempts             = 10
+	defaultInitialBackoffSeconds = 30
+	defaultMaxBackoffSeconds     = 600
+	defaultBackoffMultiplier     = 2
+)
+
+// retryPolicyFromProto validates an API retry policy and fills in defaults.
+// A nil policy stays nil, meaning no retries.
+func retryPolicyFromProto(p *jennahv1.RetryPolicy) (*database.RetryPolicy, error) {
+	if p == nil {
+		return nil, nil
+	}
+	if p.MaxAttempts < 1 || p.MaxAttempts > maxRetryAttempts {
+		return nil, fmt.Errorf("retry_policy.max_attempts must be between 1 and %d", maxRetryAttempts)
+	}
+	if p.InitialBackoffSeconds < 0 || p.MaxBackoffSeconds < 0 || p.BackoffMultiplier < 0 {
+		return nil, errors.New("retry_policy backoff values must not be negative")
+	}
+	if p.BackoffMultiplier != 0 && p.BackoffMultiplier < 1 {
+		return nil, errors.New("retry_policy.backoff_multiplier must be at least 1")
+	}
+
+	policy := &database.RetryPolicy{
+		MaxAttempts:           p.MaxAttempts,
+		InitialBackoffSeconds: p.InitialBackoffSeconds,
+		MaxBackoffSeconds:     p.MaxBackoffSeconds,
+		BackoffMultiplier:     p.BackoffMultiplier,
+	}
+	if policy.InitialBackoffSeconds == 0 {
+		policy.InitialBackoffSeconds = defaultInitialBackoffSeconds
+	}
+	if policy.MaxBackoffSeconds == 0 {
+		policy.MaxBackoffSeconds = defaultMaxBackoffSeconds
+	}
+	if policy.MaxBackoffSeconds < policy.InitialBackoffSeconds {
+		return nil, errors.New("retry_policy.max_backoff_seconds must not be less than initial_backoff_seconds")
+	}
+	if policy.BackoffMultiplier == 0 {
+		policy.BackoffMultiplier = defaultBackoffMultiplier
+	}
+
+	seen := make(map[string]bool)
+	for _, class := range p.RetryOn {
+		class = strings.ToUpper(strings.TrimSpace(class))
+		known := false
+		for _, c := range database.FailureClasses {
+			known = known || c == class
+		}
+		if !known {
+			return nil, fmt.Errorf("retry_policy.retry_on: unknown failure class %q (want one of %s)",
+				class, strings.Join(database.FailureClasses, ", "))
+		}
+		if !seen[class] {
+			seen[class] = true
+			policy.RetryOn = append(policy.RetryOn, class)
+		}
+	}
+	if len(policy.RetryOn) == 0 {
+		policy.RetryOn = append([]string(nil), database.FailureClasses...)
+	}
+	return policy, nil
+}
+
+// Lifecycle policy limits. GCP Batch accepts 0-10 task retries.
+const (
+	maxTaskRetries        = 10
+	defaultMaxTaskRetries = 3
+)
+
+// lifecyclePoliciesFromProto validates API lifecycle policies and resolves the
+// task retry count that goes with them.
+func lifecyclePoliciesFromProto(policies []*jennahv1.LifecyclePolicy, taskRetries int32) ([]database.LifecyclePolicy, int32, error) {
+	if taskRetries < 0 || taskRetries > maxTaskRetries {
+		return nil, 0, fmt.Errorf("max_task_retries must be between 0 and %d", maxTaskRetries)
+	}
+
+	var result []database.LifecyclePolicy
+	for i, p := range policies {
+		action := strings.ToUpper(strings.TrimSpace(p.Action))
+		if action != database.LifecycleActionRetryTask && action != database.LifecycleActionFailTask {
+			return nil, 0, fmt.Errorf("lifecycle_policies[%d].action must be %s or %s",
+				i, database.LifecycleActionRetryTask, database.LifecycleActionFailTask)
+		}
+		if len(p.ExitCodes) == 0 {
+			return nil, 0, fmt.Errorf("lifecycle_policies[%d].exit_codes must not be empty", i)
+		}
+		result = append(result, database.LifecyclePolicy{Action: action, ExitCodes: p.ExitCodes})
+	}
+
+	if len(result) > 0 && taskRetries == 0 {
+		// Both actions retry some exit codes, which needs a non-zero retry count.
+		taskRetries = defaultMaxTaskRetries
+	}
+	return result, taskRetries, nil
+}
+
+// containerUserPattern matches "name", "uid", "name:group" and "uid:gid".
+var containerUserPattern = regexp.MustCompile(`^([a-z_][a-z0-9_.-]*|[0-9]+)(:([a-z_][a-z0-9_.-]*|[0-9]+))?$`)
+
+// containerOptionsFromProto validates API container options. Options with no
+// fields set become nil.
+func containerOptionsFromProto(o *jennahv1.ContainerOptions) (*database.ContainerOptions, error) {
+	if o == nil || (o.WorkingDir == "" && o.User == "") {
+		return nil, nil
+	}
+
+	options := &database.ContainerOptions{User: o.User}
+	if o.WorkingDir != "" {
+		// The provider passes these on as command-line flags, so no whitespace.
+		if !path.IsAbs(o.WorkingDir) || strings.ContainsAny(o.WorkingDir, " \t\r\n") {
+			return nil, errors.New("container_options.working_dir must be an absolute path without whitespace")
+		}
+		options.WorkingDir = path.Clean(o.WorkingDir)
+	}
+	if o.User != "" && !containerUserPattern.MatchString(o.User) {
+		return nil, errors.New(`container_options.user must be "name", "uid" or "uid:gid"`)
+	}
+	return options, nil
+}
+
+// maxScriptBytes limits inline script text, which is stored in every job spec.
+const maxScriptBytes = 64 << 10
+
+// scriptFromProto validates an API script runnable. A nil script stays nil.
+func scriptFromProto(p *jennahv1.Script) (*database.Script, error) {
+	if p == nil {
+		return nil, nil
+	}
+	if strings.TrimSpace(p.Text) == "" {
+		return nil, errors.New("script.text is required")
+	}
+	if len(p.Text) > maxScriptBytes {
+		return nil, fmt.Errorf("script.text must be at most %d bytes", maxScriptBytes)
+	}
+	interpreter := strings.TrimSpace(p.Interpreter)
+	if interpreter != "" && (!strings.HasPrefix(interpreter, "/") || strings.ContainsAny(interpreter, "\r\n")) {
+		return nil, errors.New("script.interpreter must be an absolute path on a single line, e.g. /bin/bash")
+	}
+	return &database.Script{Text: p.Text, Interpreter: interpreter}, nil
+}
+
+// maxSteps limits the runnables of a multi-step job.
+const maxSteps = 32
+
+// stepsFromProto validates the steps of a multi-step job.
+func stepsFromProto(steps []*jennahv1.Step) ([]database.Step, error) {
+	if len(steps) > maxSteps {
+		return nil, fmt.Errorf("at most %d steps are allowed", maxSteps)
+	}
+
+	var result []database.Step
+	foreground := false
+	for i, p := range steps {
+		if countSet(p.ImageUri != "", p.Script != nil, p.Barrier) != 1 {
+			return nil, fmt.Errorf("steps[%d]: exactly one of image_uri, script and barrier is required", i)
+		}
+		if p.ImageUri == "" && (len(p.Commands) > 0 || p.Entrypoint != "" || p.ContainerOptions != nil) {
+			return nil, fmt.Errorf("steps[%d]: commands, entrypoint and container_options only apply to image_uri steps", i)
+		}
+		if p.Barrier && (len(p.EnvVars) > 0 || p.Background || p.IgnoreExitStatus) {
+			return nil, fmt.Errorf("steps[%d]: barrier steps cannot set env_vars, background or ignore_exit_status", i)
+		}
+
+		containerOptions, err := containerOptionsFromProto(p.ContainerOptions)
+		if err != nil {
+			return nil, fmt.Errorf("steps[%d]: %w", i, err)
+		}
+		script, err := scriptFromProto(p.Script)
+		if err != nil {
+			return nil, fmt.Errorf("steps[%d]: %w", i, err)
+		}
+
+		foreground = foreground || (!p.Barrier && !p.Background)
+		result = append(result, database.Step{
+			Name:             strings.TrimSpace(p.Name),
+			ImageURI:         p.ImageUri,
+			Commands:         p.Commands,
+			Entrypoint:       p.Entrypoint,
+			ContainerOptions: containerOptions,
+			Script:           script,
+			Barrier:          p.Barrier,
+			EnvVars:          p.EnvVars,
+			Background:       p.Background,
+			IgnoreExitStatus: p.IgnoreExitStatus,
+		})
+	}
+	if len(result) > 0 && !foreground {
+		// Background steps are stopped when the foreground ones finish.
+		return nil, errors.New("steps must include at least one container or script step that is not in the background")
+	}
+	return result, nil
+}
+
+// maxTaskCount limits how many tasks one job can fan out into.
+const maxTaskCount = 10000
+
+// taskFanOutFromProto validates an API task count and parallelism, turning an
+// unset task count into 1. A parallelism of 0 stays 0 (provider default).
+func taskFanOutFromProto(taskCount, parallelism int64) (int64, int64, error) {
+	if taskCount == 0 {
+		taskCount = 1
+	}
+	if taskCount < 1 || taskCount > maxTaskCount {
+		return 0, 0, fmt.Errorf("task_count must be between 1 and %d", maxTaskCount)
+	}
+	if parallelism < 0 || parallelism > taskCount {
+		return 0, 0, errors.New("parallelism must be between 0 and task_count")
+	}
+	return taskCount, parallelism, nil
+}
+
+// maxVolumes limits the volumes mounted into one job.
+const maxVolumes = 16
+
+// gcsBucketPattern matches Cloud Storage bucket names.
+var gcsBucketPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,220}[a-z0-9]$`)
+
+// diskTypes are the accepted DiskVolume types; empty means pd-balanced.
+var diskTypes = map[string]bool{"": true, "pd-balanced": true, "pd-standard": true, "pd-ssd": true, "local-ssd": true}
+
+// volumesFromProto validates API volumes, rejecting mount paths that equal or
+// contain one another.
+func volumesFromProto(volumes []*jennahv1.Volume) ([]database.Volume, error) {
+	if len(volumes) > maxVolumes {
+		return nil, fmt.Errorf("at most %d volumes are allowed", maxVolumes)
+	}
+
+	var result []database.Volume
+	for i, p := range volumes {
+		if !path.IsAbs(p.MountPath) || path.Clean(p.MountPath) == "/" {
+			return nil, fmt.Errorf("volumes[%d]: mount_path must be an absolute path other than /", i)
+		}
+		volume := database.Volume{MountPath: path.Clean(p.MountPath), ReadOnly: p.ReadOnly}
+		for j, other := range result {
+			if mountsOverlap(volume.MountPath, other.MountPath) {
+				return nil, fmt.Errorf("volumes[%d]: mount_path %s collides with volumes[%d] at %s", i, volume.MountPath, j, other.MountPath)
+			}
+		}
+
+		if countSet(p.Gcs != nil, p.Nfs != nil, p.Disk != nil) != 1 {
+			return nil, fmt.Errorf("volumes[%d]: exactly one of gcs, nfs and disk is required", i)
+		}
+		switch {
+		case p.Gcs != nil:
+			if !gcsBucketPattern.MatchString(p.Gcs.Bucket) {
+				return nil, fmt.Errorf("volumes[%d]: gcs.bucket must be a bucket name without gs://", i)
+			}
+			volume.GCS = &database.GCSVolume{Bucket: p.Gcs.Bucket, Path: strings.Trim(p.Gcs.Path, "/")}
+		case p.Nfs != nil:
+			if p.Nfs.Server == "" || strings.ContainsAny(p.Nfs.Server, " /:") {
+				return nil, fmt.Errorf("volumes[%d]: nfs.server must be a host name or IP address", i)
+			}
+			if !path.IsAbs(p.Nfs.RemotePath) {
+				return nil, fmt.Errorf("volumes[%d]: nfs.remote_path must be an absolute path", i)
+			}
+			volume.NFS = &database.NFSVolume{Server: p.Nfs.Server, RemotePath: path.Clean(p.Nfs.RemotePath)}
+		case p.Disk != nil:
+			if p.ReadOnly {
+				return nil, fmt.Errorf("volumes[%d]: disk volumes start empty and cannot be read_only", i)
+			}
+			if !diskTypes[p.Disk.Type] {
+				return nil, fmt.Errorf("volumes[%d]: disk.type must be pd-balanced, pd-standard, pd-ssd or local-ssd", i)
+			}
+			if p.Disk.SizeGb < 1 || p.Disk.SizeGb > 65536 {
+				return nil, fmt.Errorf("volumes[%d]: disk.size_gb must be between 1 and 65536", i)
+			}
+			if p.Disk.Type == "local-ssd" && p.Disk.SizeGb%375 != 0 {
+				return nil, fmt.Errorf("volumes[%d]: local-ssd disk.size_gb must be a multiple of 375", i)
+			}
+			volume.Disk = &database.DiskVolume{SizeGB: p.Disk.SizeGb, Type: p.Disk.Type}
+		}
+		result = append(result, volume)
+	}
+	return result, nil
+}
+
+// mountsOverlap reports whether two clean mount paths are the same or one is
+// inside the other, which would hide part of a volume.
+func mountsOverlap(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+// labelsFromProto validates a job's user labels. An empty map becomes nil.
+func labelsFromProto(labels map[string]string) (map[string]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	if err := batch.ValidateUserLabels(labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// schedulingFromProto validates an API priority and allocation with the rules
+// LoadJobConfig applies to profile defaults.
+func schedulingFromProto(priority int64, a *jennahv1.Allocation) (config.Scheduling, error) {
+	if priority < 0 || priority > config.MaxPriority {
+		return config.Scheduling{}, fmt.Errorf("priority must be between 0 and %d", config.MaxPriority)
+	}
+	scheduling := config.Scheduling{Priority: priority}
+	if a == nil {
+		return scheduling, nil
+	}
+
+	if a.MachineType != "" && !config.MachineTypePattern.MatchString(a.MachineType) {
+		return config.Scheduling{}, errors.New(`allocation.machine_type must be a machine type such as "n2-standard-4" or a family such as "n2"`)
+	}
+	scheduling.MachineType = a.MachineType
+
+	switch model := strings.ToUpper(a.ProvisioningModel); batch.ProvisioningModel(model) {
+	case "", batch.ProvisioningModelStandard, batch.ProvisioningModelSpot:
+		scheduling.ProvisioningModel = model
+	default:
+		return config.Scheduling{}, fmt.Errorf("allocation.provisioning_model must be %s or %s", batch.ProvisioningModelStandard, batch.ProvisioningModelSpot)
+	}
+
+	for _, zone := range a.Zones {
+		if !config.ZonePattern.MatchString(zone) {
+			return config.Scheduling{}, fmt.Errorf("allocation.zones: %q is not a zone name such as asia-northeast1-a", zone)
+		}
+	}
+	scheduling.Zones = a.Zones
+	return scheduling, nil
+}
+
+// applyScheduling resolves a job's priority and allocation from its resource
+// profile and the values set on the job.
+func (s *WorkerServer) applyScheduling(spec *database.JobSpec, override config.Scheduling) {
+	scheduling := s.jobConfig.ResolveScheduling(spec.ResourceProfile, override)
+	spec.Priority = scheduling.Priority
+	spec.Allocation = nil
+	if scheduling.MachineType != "" || scheduling.ProvisioningModel != "" || len(scheduling.Zones) > 0 {
+		spec.Allocation = &database.Allocation{
+			MachineType:       scheduling.MachineType,
+			ProvisioningModel: strings.ToUpper(scheduling.ProvisioningModel),
+			Zones:             scheduling.Zones,
+		}
+	}
+}
+
+const (
+	// defaultPreemptionRetries applies to jobs submitted without a preemption policy.
+	defaultPreemptionRetries = 3
+	// maxPreemptionRetries limits preemption_policy.max_retries.
+	maxPreemptionRetries = 10
+)
+
+// preemptionPolicyFromProto validates an API preemption policy against the
+// job's resolved allocation. An unset policy gets the default budget.
+func preemptionPolicyFromProto(p *jennahv1.PreemptionPolicy, allocation *database.Allocation) (*database.PreemptionPolicy, error) {
+	if p == nil {
+		return &database.PreemptionPolicy{MaxRetries: defaultPreemptionRetries}, nil
+	}
+	if p.MaxRetries < 0 || p.MaxRetries > maxPreemptionRetries {
+		return nil, fmt.Errorf("preemption_policy.max_retries must be between 0 and %d", maxPreemptionRetries)
+	}
+	if p.FallbackToStandard && (allocation == nil || allocation.ProvisioningModel != string(batch.ProvisioningModelSpot)) {
+		return nil, errors.New("preemption_policy.fallback_to_standard only applies to jobs on SPOT VMs")
+	}
+	return &database.PreemptionPolicy{MaxRetries: p.MaxRetries, FallbackToStandard: p.FallbackToStandard}, nil
+}
+
+// maxSecretEnvVars limits secret_env_vars.
+const maxSecretEnvVars = 64
+
+// envVarNamePattern is a portable environment variable name.
+var envVarNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// secretEnvVarsFromProto validates secret env var references, defaulting the
+// version to latest. A name may not also be set in envVars.
+func secretEnvVarsFromProto(secretEnvVars map[string]*jennahv1.SecretEnvVar, envVars map[string]string) (map[string]database.SecretRef, error) {
+	if len(secretEnvVars) == 0 {
+		return nil, nil
+	}
+	if len(secretEnvVars) > maxSecretEnvVars {
+		return nil, fmt.Errorf("at most %d secret_env_vars are allowed", maxSecretEnvVars)
+	}
+	refs := make(map[string]database.SecretRef, len(secretEnvVars))
+	for name, v := range secretEnvVars {
+		if !envVarNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("secret_env_vars name %q must be letters, digits and underscores, not starting with a digit", name)
+		}
+		if _, ok := envVars[name]; ok {
+			return nil, fmt.Errorf("%s is set in both env_vars and secret_env_vars", name)
+		}
+		if v == nil {
+			return nil, fmt.Errorf("secret_env_vars[%s] needs a secret", name)
+		}
+		ref := secrets.Ref{Name: v.Secret, Version: v.Version}
+		if ref.Version == "" {
+			ref.Version = secrets.LatestVersion
+		}
+		if err := ref.Validate(); err != nil {
+			return nil, fmt.Errorf("secret_env_vars[%s]: %w", name, err)
+		}
+		refs[name] = database.SecretRef{Secret: ref.Name, Version: ref.Version}
+	}
+	return refs, nil
+}
+
+// requestIDPattern is a valid SubmitJob request_id.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// submitRequestFromProto validates the request ID of a submission and
+// fingerprints the rest of its payload. It returns nil when no request ID is
+// set; the job ID is filled in by submitJobSpec.
+func (s *WorkerServer) submitRequestFromProto(tenantId string, req *jennahv1.SubmitJobRequest) (*database.SubmitRequest, error) {
+	if req.RequestId == "" {
+		return nil, nil
+	}
+	if !requestIDPattern.MatchString(req.RequestId) {
+		return nil, errors.New(`request_id must be 1-128 letters, digits, ".", "_", ":" or "-"`)
+	}
+	payload := proto.Clone(req).(*jennahv1.SubmitJobRequest)
+	payload.RequestId = ""
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash request: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return &database.SubmitRequest{
+		TenantId:    tenantId,
+		RequestId:   req.RequestId,
+		PayloadHash: hex.EncodeToString(sum[:]),
+		ExpiresAt:   time.Now().Add(s.requestIDTTL),
+	}, nil
+}
+
+// replaySubmitRequest returns the response to a repeated request ID: the
+// recorded status or error, or the job's current status if the first request
+// never recorded its response. A different payload fails with AlreadyExists.
+func (s *WorkerServer) replaySubmitRequest(ctx context.Context, existing *database.SubmitRequest, payloadHash string) (string, string, error) {
+	if existing.PayloadHash != payloadHash {
+		return "", "", connect.NewError(connect.CodeAlreadyExists,
+			fmt.Errorf("request_id %s was already used for a different submission (job %s)", existing.RequestId, existing.JobId))
+	}
+	log.Printf("Replaying request %s of tenant %s: job %s", existing.RequestId, existing.TenantId, existing.JobId)
+	if existing.Status != nil {
+		return existing.JobId, *existing.Status, nil
+	}
+	if existing.ErrorCode != nil {
+		code := connect.CodeInternal
+		if err := code.UnmarshalText([]byte(*existing.ErrorCode)); err != nil {
+			log.Printf("Unknown error code %q recorded for request %s", *existing.ErrorCode, existing.RequestId)
+		}
+		message := ""
+		if existing.ErrorMessage != nil {
+			message = *existing.ErrorMessage
+		}
+		return "", "", connect.NewError(code, errors.New(message))
+	}
+	job, err := s.dbClient.GetJob(ctx, existing.TenantId, existing.JobId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return "", "", connect.NewError(connect.CodeNotFound,
+				fmt.Errorf("job %s created for request_id %s no longer exists", existing.JobId, existing.RequestId))
+		}
+		log.Printf("Error getting job %s: %v", existing.JobId, err)
+		return "", "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+	}
+	return job.JobId, job.Status, nil
+}
+
+// countSet returns how many of the given conditions hold.
+func countSet(conditions ...bool) int {
+	n := 0
+	for _, c := range conditions {
+		if c {
+			n++
+		}
+	}
+	return n
+}
+
+// dispatcher returns the attempt dispatcher backed by this server's clients.
+func (s *WorkerServer) dispatcher() dispatcher {
+	return dispatcher{dbClient: s.dbClient, batchProvider: s.batchProvider, secrets: s.secrets}
+}
+
+// buildJobSpec normalizes a submission into a JobSpec, resolving the named
+// preset merged with any per-field override into concrete resources.
+func (s *WorkerServer) buildJobSpec(imageUri string, envVars map[string]string, profile string, override *database.ResourceValues) *database.JobSpec {
+	var resourceOverride *config.ResourceOverride
+	if override != nil {
+		resourceOverride = &config.ResourceOverride{
+			CPUMillis:             override.CPUMillis,
+			MemoryMiB:             override.MemoryMiB,
+			MaxRunDurationSeconds: override.MaxRunDurationSeconds,
+		}
+	}
+	resources := s.jobConfig.ResolveResources(profile, resourceOverride)
+
+	return &database.JobSpec{
+		Version:          database.JobSpecVersion,
+		ImageURI:         imageUri,
+		EnvVars:          envVars,
+		ResourceProfile:  profile,
+		ResourceOverride: override,
+		Resources: database.ResourceValues{
+			CPUMillis:             resources.CPUMillis,
+			MemoryMiB:             resources.MemoryMiB,
+			MaxRunDurationSeconds: resources.MaxRunDurationSeconds,
+		},
+	}
+}
+
+// submitJobSpec records a new PENDING job for spec and dispatches its first
+// attempt to the batch provider. It is the shared path behind SubmitJob and
+// RerunJob; clonedFrom is the source job ID for reruns and empty otherwise.
+// A non-nil request is recorded with the job, and a request ID that another
+// submission claimed first is answered like a repeated request.
+// Returned errors are connect errors ready to send to the caller.
+func (s *WorkerServer) submitJobSpec(ctx context.Context, tenantId string, spec *database.JobSpec, clonedFrom string, request *database.SubmitRequest) (string, string, error) {
+	// Check secret references up front so a bad one is reported to the
+	// caller instead of failing the first attempt.
+	if _, err := s.dispatcher().resolveSecrets(ctx, spec.SecretEnvVars); err != nil {
+		switch {
+		case errors.Is(err, errSecretsDisabled):
+			return "", "", connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, secrets.ErrNotFound):
+			return "", "", connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		log.Printf("Error resolving secrets: %v", err)
+		return "", "", connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Generate internal UUID for Spanner primary key
+	internalJobID := uuid.New().String()
+	log.Printf("Generated internal job ID: %s", internalJobID)
+
+	if request != nil {
+		request.JobId = internalJobID
+	}
+
+	// Insert job record with PENDING status
+	err := s.dbClient.InsertJob(ctx, tenantId, internalJobID, spec, clonedFrom, request)
+	if errors.Is(err, database.ErrDuplicateRequest) {
+		existing, err := s.dbClient.GetSubmitRequest(ctx, tenantId, request.RequestId)
+		if err != nil || existing == nil {
+			log.Printf("Error reading request %s after losing insert race: %v", request.RequestId, err)
+			return "", "", connect.NewError(connect.CodeAborted, fmt.Errorf("request_id %s is being submitted concurrently; retry", request.RequestId))
+		}
+		return s.replaySubmitRequest(ctx, existing, request.PayloadHash)
+	}
+	if err != nil {
+		log.Printf("Error inserting job to database: %v", err)
+		return "", "", connect.NewError(
+			connect.CodeInternal,
+			fmt.Errorf("failed to create job record: %w", err),
+		)
+	}
+	log.Printf("Job %s saved to database with PENDING status", internalJobID)
+
+	status, err := s.dispatcher().dispatch(ctx, tenantId, internalJobID, spec, 0, 0)
+	if err != nil {
+		connectErr := connect.NewError(connect.CodeInternal, err)
+		if request != nil {
+			if err := s.dbClient.RecordSubmitError(ctx, tenantId, request.RequestId, connectErr.Code().String(), connectErr.Message()); err != nil {
+				log.Printf("Error recording error of request %s: %v", request.RequestId, err)
+			}
+		}
+		return "", "", connectErr
+	}
+	if request != nil {
+		// Without the recorded status, repeats fall back to the job's current one.
+		if err := s.dbClient.RecordSubmitResponse(ctx, tenantId, request.RequestId, status); err != nil {
+			log.Printf("Error recording response to request %s: %v", request.RequestId, err)
+		}
+	}
+
+	return internalJobID, status, nil
+}
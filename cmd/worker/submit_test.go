@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/secrets"
+)
+
+func TestSubmitJobSecretEnvVars(t *testing.T) {
+	dir := t.TempDir()
+	secretPath := filepath.Join(dir, "db-password", "3")
+	if err := os.MkdirAll(filepath.Dir(secretPath), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(secretPath, []byte("hunter2"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	w := newTestWorker(t, "")
+	w.server.secrets = secrets.NewFileResolver(dir)
+
+	resp, err := w.submit(t, &jennahv1.SubmitJobRequest{
+		ImageUri:      "busybox",
+		SecretEnvVars: map[string]*jennahv1.SecretEnvVar{"DB_PASSWORD": {Secret: "db-password", Version: "3"}},
+	})
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+	config := w.submittedConfig(t, resp.JobId)
+	if got := config.SecretEnvVars["DB_PASSWORD"]; got != secretPath {
+		t.Errorf("provider secret env var = %q, want %q", got, secretPath)
+	}
+	if _, ok := config.EnvVars["DB_PASSWORD"]; ok {
+		t.Error("secret env var was passed as a plain env var")
+	}
+
+	tests := []struct {
+		name string
+		msg  *jennahv1.SubmitJobRequest
+		code connect.Code
+	}{
+		{
+			name: "unknown secret",
+			msg: &jennahv1.SubmitJobRequest{
+				ImageUri:      "busybox",
+				SecretEnvVars: map[string]*jennahv1.SecretEnvVar{"API_TOKEN": {Secret: "api-token"}},
+			},
+			code: connect.CodeInvalidArgument,
+		},
+		{
+			name: "also a plain env var",
+			msg: &jennahv1.SubmitJobRequest{
+				ImageUri:      "busybox",
+				EnvVars:       map[string]string{"DB_PASSWORD": "hunter2"},
+				SecretEnvVars: map[string]*jennahv1.SecretEnvVar{"DB_PASSWORD": {Secret: "db-password", Version: "3"}},
+			},
+			code: connect.CodeInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := w.submit(t, tt.msg); connect.CodeOf(err) != tt.code {
+				t.Errorf("SubmitJob: %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestSubmitJobSecretEnvVarsDisabled(t *testing.T) {
+	w := newTestWorker(t, "")
+	_, err := w.submit(t, &jennahv1.SubmitJobRequest{
+		ImageUri:      "busybox",
+		SecretEnvVars: map[string]*jennahv1.SecretEnvVar{"DB_PASSWORD": {Secret: "db-password"}},
+	})
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("SubmitJob: %v, want failed_precondition", err)
+	}
+}
+
+func TestSubmitJobRequestID(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWorker(t, "")
+	msg := &jennahv1.SubmitJobRequest{ImageUri: "busybox", EnvVars: map[string]string{"A": "1"}, RequestId: "export-2026-10-16"}
+
+	first, err := w.submit(t, msg)
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+
+	// Advance the job so a replay can be told apart from a fresh status.
+	w.reconciler.reconcileOnce(ctx)
+
+	// The same payload, in a different message with map entries in another
+	// order, replays the first response.
+	repeat, err := w.submit(t, &jennahv1.SubmitJobRequest{RequestId: "export-2026-10-16", EnvVars: map[string]string{"A": "1"}, ImageUri: "busybox"})
+	if err != nil {
+		t.Fatalf("repeated SubmitJob: %v", err)
+	}
+	if repeat.JobId != first.JobId || repeat.Status != first.Status {
+		t.Errorf("repeated SubmitJob = %s %s, want %s %s", repeat.JobId, repeat.Status, first.JobId, first.Status)
+	}
+	if paths, _ := w.provider.ListJobs(ctx); len(paths) != 1 {
+		t.Errorf("provider has %d jobs, want 1", len(paths))
+	}
+
+	// A different payload under the same ID is rejected.
+	changed := proto.Clone(msg).(*jennahv1.SubmitJobRequest)
+	changed.EnvVars["A"] = "2"
+	if _, err := w.submit(t, changed); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("SubmitJob with a changed payload: %v, want already_exists", err)
+	}
+
+	// Another ID creates another job.
+	other := proto.Clone(msg).(*jennahv1.SubmitJobRequest)
+	other.RequestId = "export-2026-10-17"
+	resp, err := w.submit(t, other)
+	if err != nil {
+		t.Fatalf("SubmitJob with another request ID: %v", err)
+	}
+	if resp.JobId == first.JobId {
+		t.Error("another request ID replayed the first job")
+	}
+}
+
+func TestSubmitJobRequestIDReplaysCurrentStatus(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWorker(t, "")
+	msg := &jennahv1.SubmitJobRequest{ImageUri: "busybox", RequestId: "req-1"}
+	request, err := w.server.submitRequestFromProto(testTenant, msg)
+	if err != nil {
+		t.Fatalf("submitRequestFromProto: %v", err)
+	}
+	first, err := w.submit(t, msg)
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+	w.reconcileUntilDone(t, first.JobId)
+
+	// Without a recorded response, a replay reports the job's current status.
+	existing, err := w.db.GetSubmitRequest(ctx, testTenant, "req-1")
+	if err != nil || existing == nil {
+		t.Fatalf("GetSubmitRequest = %v, %v", existing, err)
+	}
+	existing.Status = nil
+	jobID, status, err := w.server.replaySubmitRequest(ctx, existing, request.PayloadHash)
+	if err != nil {
+		t.Fatalf("replaySubmitRequest: %v", err)
+	}
+	if jobID != first.JobId || status != database.JobStatusCompleted {
+		t.Errorf("replaySubmitRequest = %s %s, want %s %s", jobID, status, first.JobId, database.JobStatusCompleted)
+	}
+}
+
+func TestSubmitJobRequestIDReplaysFailedSubmission(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWorker(t, "")
+	w.server.batchProvider = unrecordableProvider{w.provider}
+	msg := &jennahv1.SubmitJobRequest{ImageUri: "busybox", RequestId: "req-1"}
+
+	_, firstErr := w.submit(t, msg)
+	if connect.CodeOf(firstErr) != connect.CodeInternal {
+		t.Fatalf("SubmitJob: %v, want internal", firstErr)
+	}
+
+	// The repeat fails the same way instead of reporting the FAILED job as
+	// submitted, and does not submit it again.
+	_, err := w.submit(t, msg)
+	if connect.CodeOf(err) != connect.CodeInternal {
+		t.Fatalf("repeated SubmitJob: %v, want internal", err)
+	}
+	var connectErr, firstConnectErr *connect.Error
+	if !errors.As(err, &connectErr) || !errors.As(firstErr, &firstConnectErr) || connectErr.Message() != firstConnectErr.Message() {
+		t.Errorf("repeated SubmitJob error = %v, want %v", err, firstErr)
+	}
+	if paths, _ := w.provider.ListJobs(ctx); len(paths) != 1 {
+		t.Errorf("provider has %d jobs, want 1", len(paths))
+	}
+}
+
+func TestSubmitJobRequestIDLosesInsertRace(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWorker(t, "")
+	msg := &jennahv1.SubmitJobRequest{ImageUri: "busybox", RequestId: "req-1"}
+	first, err := w.submit(t, msg)
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+
+	// A concurrent repeat that passed the early check before the first
+	// request was recorded replays it instead of creating a job.
+	request, err := w.server.submitRequestFromProto(testTenant, msg)
+	if err != nil {
+		t.Fatalf("submitRequestFromProto: %v", err)
+	}
+	spec := w.server.buildJobSpec(msg.ImageUri, nil, "", nil)
+	jobID, _, err := w.server.submitJobSpec(ctx, testTenant, spec, "", request)
+	if err != nil {
+		t.Fatalf("submitJobSpec: %v", err)
+	}
+	if jobID != first.JobId {
+		t.Errorf("submitJobSpec = %s, want the first job %s", jobID, first.JobId)
+	}
+	if paths, _ := w.provider.ListJobs(ctx); len(paths) != 1 {
+		t.Errorf("provider has %d jobs, want 1", len(paths))
+	}
+}
+
+func TestSubmitRequestFromProto(t *testing.T) {
+	s := &WorkerServer{requestIDTTL: time.Hour}
+	if request, err := s.submitRequestFromProto(testTenant, &jennahv1.SubmitJobRequest{ImageUri: "busybox"}); request != nil || err != nil {
+		t.Errorf("without request_id = %v, %v, want nil, nil", request, err)
+	}
+	for _, id := range []string{"has space", "slash/ed", strings.Repeat("a", 129)} {
+		if _, err := s.submitRequestFromProto(testTenant, &jennahv1.SubmitJobRequest{ImageUri: "busybox", RequestId: id}); err == nil {
+			t.Errorf("request_id %q was accepted", id)
+		}
+	}
+
+	a, err := s.submitRequestFromProto(testTenant, &jennahv1.SubmitJobRequest{ImageUri: "busybox", RequestId: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := s.submitRequestFromProto(testTenant, &jennahv1.SubmitJobRequest{ImageUri: "busybox", RequestId: "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.PayloadHash != b.PayloadHash {
+		t.Error("the payload hash depends on the request ID")
+	}
+	if until := time.Until(a.ExpiresAt); until <= 0 || until > time.Hour {
+		t.Errorf("request expires in %s, want within the 1h TTL", until)
+	}
+}
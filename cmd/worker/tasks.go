@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+func (s *WorkerServer) ListJobTasks(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListJobTasksRequest],
+) (*connect.Response[jennahv1.ListJobTasksResponse], error) {
+	tenantId := req.Header().Get("X-Tenant-Id")
+	log.Printf("Received ListJobTasks request for tenant: %s, job: %s", tenantId, req.Msg.JobId)
+
+	if tenantId == "" {
+		log.Printf("Error: X-Tenant-Id header is missing")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("X-Tenant-Id header is required"))
+	}
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+
+	job, err := s.dbClient.GetJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", req.Msg.JobId))
+		}
+		log.Printf("Error reading job %s: %v", req.Msg.JobId, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+	}
+
+	taskCount := int64(1)
+	if spec, err := job.Spec(); err != nil {
+		log.Printf("Job %s has an unreadable spec: %v", job.JobId, err)
+	} else if spec != nil && spec.TaskCount > 1 {
+		taskCount = spec.TaskCount
+	}
+
+	resp := &jennahv1.ListJobTasksResponse{
+		JobId:      job.JobId,
+		Status:     job.Status,
+		TaskCount:  taskCount,
+		TaskCounts: make(map[string]int64),
+	}
+
+	if job.CloudJobResourcePath == nil || *job.CloudJobResourcePath == "" {
+		// Nothing has reached the provider yet, so every task is waiting.
+		resp.TaskCounts[database.JobStatusPending] = taskCount
+		return connect.NewResponse(resp), nil
+	}
+
+	tasks, err := s.batchProvider.ListTasks(ctx, *job.CloudJobResourcePath)
+	if err != nil {
+		if database.IsTerminalStatus(job.Status) {
+			// Finished jobs may already be gone from the provider (cancelling
+			// a GCP job deletes it); the job status still stands.
+			log.Printf("Tasks of finished job %s are unavailable: %v", job.JobId, err)
+			return connect.NewResponse(resp), nil
+		}
+		log.Printf("Error listing tasks of batch job %s: %v", *job.CloudJobResourcePath, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list job tasks: %w", err))
+	}
+
+	for _, t := range tasks {
+		resp.TaskCounts[string(t.Status)]++
+		resp.Tasks = append(resp.Tasks, &jennahv1.JobTask{
+			Index:    t.Index,
+			Status:   string(t.Status),
+			ExitCode: t.ExitCode,
+			Message:  t.Message,
+		})
+	}
+
+	log.Printf("Retrieved %d tasks for job %s", len(resp.Tasks), job.JobId)
+	return connect.NewResponse(resp), nil
+}
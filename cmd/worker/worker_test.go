@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/batch"
+	"github.com/alphauslabs/jennah/internal/batch/fake"
+	"github.com/alphauslabs/jennah/internal/config"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/database/dbtest"
+)
+
+const testTenant = "tenant-1"
+
+// testWorker is a WorkerServer and Reconciler backed by spannertest and the
+// fake batch provider.
+type testWorker struct {
+	server     *WorkerServer
+	reconciler *Reconciler
+	provider   *fake.FakeBatchProvider
+	db         *database.Client
+}
+
+// newTestWorker returns a worker whose fake jobs end in outcome ("" for
+// COMPLETED).
+func newTestWorker(t *testing.T, outcome string) *testWorker {
+	t.Helper()
+	db := dbtest.NewClient(t)
+	provider, err := fake.NewFakeBatchProvider(context.Background(), batch.ProviderConfig{
+		ProviderOptions: map[string]string{"outcome": outcome},
+	})
+	if err != nil {
+		t.Fatalf("NewFakeBatchProvider: %v", err)
+	}
+	return &testWorker{
+		server: &WorkerServer{
+			dbClient:      db,
+			batchProvider: provider,
+			jobConfig:     &config.JobConfigFile{DefaultResources: config.ResourceProfile{CPUMillis: 1000, MemoryMiB: 512}},
+			requestIDTTL:  time.Hour,
+		},
+		reconciler: NewReconciler(db, provider, nil, time.Second, 1),
+		provider:   provider.(*fake.FakeBatchProvider),
+		db:         db,
+	}
+}
+
+// tenantRequest wraps msg in a request from testTenant.
+func tenantRequest[T any](msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("X-Tenant-Id", testTenant)
+	return req
+}
+
+// submit sends a SubmitJob request for testTenant.
+func (w *testWorker) submit(t *testing.T, msg *jennahv1.SubmitJobRequest) (*jennahv1.SubmitJobResponse, error) {
+	t.Helper()
+	resp, err := w.server.SubmitJob(context.Background(), tenantRequest(msg))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// submittedConfig returns the provider configuration of a job's current attempt.
+func (w *testWorker) submittedConfig(t *testing.T, jobID string) batch.JobConfig {
+	t.Helper()
+	job, err := w.db.GetJob(context.Background(), testTenant, jobID)
+	if err != nil {
+		t.Fatalf("GetJob: %v", err)
+	}
+	if job.CloudJobResourcePath == nil {
+		t.Fatalf("job %s was not submitted to the provider", jobID)
+	}
+	config, ok := w.provider.JobConfig(*job.CloudJobResourcePath)
+	if !ok {
+		t.Fatalf("provider has no job %s", *job.CloudJobResourcePath)
+	}
+	return config
+}
+
+// reconcileUntilDone runs reconciliation passes until the job is terminal.
+func (w *testWorker) reconcileUntilDone(t *testing.T, jobID string) *database.Job {
+	t.Helper()
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		w.reconciler.reconcileOnce(ctx)
+		job, err := w.db.GetJob(ctx, testTenant, jobID)
+		if err != nil {
+			t.Fatalf("GetJob: %v", err)
+		}
+		if database.IsTerminalStatus(job.Status) {
+			return job
+		}
+	}
+	t.Fatalf("job %s did not finish", jobID)
+	return nil
+}
+
+func TestSubmitJobRunsToCompletion(t *testing.T) {
+	w := newTestWorker(t, "")
+	resp, err := w.submit(t, &jennahv1.SubmitJobRequest{ImageUri: "busybox"})
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+	if resp.Status != database.JobStatusPending {
+		t.Errorf("SubmitJob status = %s, want %s", resp.Status, database.JobStatusPending)
+	}
+
+	job := w.reconcileUntilDone(t, resp.JobId)
+	if job.Status != database.JobStatusCompleted {
+		t.Fatalf("status = %s, want %s", job.Status, database.JobStatusCompleted)
+	}
+	if job.ExitCode == nil || *job.ExitCode != 0 {
+		t.Errorf("exit code = %v, want 0", job.ExitCode)
+	}
+
+	transitions, err := w.db.GetJobTransitions(context.Background(), testTenant, resp.JobId)
+	if err != nil {
+		t.Fatalf("GetJobTransitions: %v", err)
+	}
+	want := []string{database.JobStatusPending, database.JobStatusScheduled, database.JobStatusRunning, database.JobStatusCompleted}
+	if len(transitions) != len(want) {
+		t.Fatalf("got %d transitions, want %d", len(transitions), len(want))
+	}
+	for i, tr := range transitions {
+		if tr.ToStatus != want[i] {
+			t.Errorf("transition %d to %s, want %s", i, tr.ToStatus, want[i])
+		}
+	}
+}
+
+func TestSubmitJobStoresProviderJobUID(t *testing.T) {
+	w := newTestWorker(t, "")
+	resp, err := w.submit(t, &jennahv1.SubmitJobRequest{ImageUri: "busybox"})
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+
+	attempts, err := w.db.ListJobAttempts(context.Background(), testTenant, resp.JobId)
+	if err != nil {
+		t.Fatalf("ListJobAttempts: %v", err)
+	}
+	if len(attempts) != 1 || attempts[0].ProviderJobId == nil {
+		t.Fatalf("got %d attempts, want 1 submitted attempt", len(attempts))
+	}
+	want := "uid-" + *attempts[0].ProviderJobId
+	if attempts[0].ProviderJobUid == nil || *attempts[0].ProviderJobUid != want {
+		t.Errorf("provider job UID = %v, want %s", attempts[0].ProviderJobUid, want)
+	}
+}
+
+func TestSubmitJobRecordsFailure(t *testing.T) {
+	w := newTestWorker(t, string(batch.JobStatusFailed))
+	resp, err := w.submit(t, &jennahv1.SubmitJobRequest{ImageUri: "busybox"})
+	if err != nil {
+		t.Fatalf("SubmitJob: %v", err)
+	}
+
+	job := w.reconcileUntilDone(t, resp.JobId)
+	if job.Status != database.JobStatusFailed {
+		t.Fatalf("status = %s, want %s", job.Status, database.JobStatusFailed)
+	}
+	if job.ExitCode == nil || *job.ExitCode != 1 {
+		t.Errorf("exit code = %v, want 1", job.ExitCode)
+	}
+	if job.FailureCategory == nil || *job.FailureCategory != string(batch.FailureCategoryUserError) {
+		t.Errorf("failure category = %v, want %s", job.FailureCategory, batch.FailureCategoryUserError)
+	}
+}
+
+func TestSubmitJobRequiresTenant(t *testing.T) {
+	w := newTestWorker(t, "")
+	_, err := w.server.SubmitJob(context.Background(), connect.NewRequest(&jennahv1.SubmitJobRequest{ImageUri: "busybox"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("SubmitJob without tenant: %v, want invalid_argument", err)
+	}
+}
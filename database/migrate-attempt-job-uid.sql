@@ -0,0 +1,8 @@
+-- Migration: Add ProviderJobUid to JobAttempts
+-- Description: Stores the provider's unique ID of each attempt's job as
+--              returned at submission. GCP Batch labels task logs with this
+--              UID rather than the job name, so keeping it lets the gateway
+--              read an attempt's logs without looking the job up, including
+--              after the job has been deleted from Batch.
+
+ALTER TABLE JobAttempts ADD COLUMN ProviderJobUid STRING(64);
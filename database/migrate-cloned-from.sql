@@ -0,0 +1,5 @@
+-- Migration: Add ClonedFromJobId column to Jobs
+-- Description: Records which job a rerun was cloned from. NULL for jobs that
+--              were submitted directly.
+
+ALTER TABLE Jobs ADD COLUMN ClonedFromJobId STRING(36);
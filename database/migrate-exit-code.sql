@@ -0,0 +1,8 @@
+-- Migration: Add ExitCode columns to Jobs and JobAttempts
+-- Description: Records the container exit code reported by the batch provider
+--              when a job (or one of its attempts) finishes. NULL when the
+--              provider did not report one.
+
+ALTER TABLE Jobs ADD COLUMN ExitCode INT64;
+
+ALTER TABLE JobAttempts ADD COLUMN ExitCode INT64;
@@ -0,0 +1,11 @@
+-- Migration: Add structured failure details to Jobs and JobAttempts
+-- Description: FailureCategory classifies why a job failed (IMAGE_PULL,
+--              OUT_OF_MEMORY, TIMEOUT, PREEMPTED, USER_ERROR, UNKNOWN) and
+--              ProviderEvents keeps the batch provider's last status events
+--              as a JSON array, so failures can be triaged from the job record.
+
+ALTER TABLE Jobs ADD COLUMN FailureCategory STRING(50);
+ALTER TABLE Jobs ADD COLUMN ProviderEvents JSON;
+
+ALTER TABLE JobAttempts ADD COLUMN FailureCategory STRING(50);
+ALTER TABLE JobAttempts ADD COLUMN ProviderEvents JSON;
@@ -0,0 +1,8 @@
+-- Migration: Add Labels column to Jobs
+-- Description: Stores the labels submitted with a job as a JSON object so
+--              ListJobs can filter by label. The same labels, plus the
+--              jennah-tenant-id and jennah-job-id system labels, are set on
+--              the provider job for billing attribution. Existing rows keep
+--              a NULL Labels and match no label selector.
+
+ALTER TABLE Jobs ADD COLUMN Labels JSON;
@@ -0,0 +1,9 @@
+-- Migration: Add resolved resource columns to Jobs
+-- Description: Persists the resource profile name and the compute resources
+--              resolved for a job at submission time (preset merged with any
+--              per-field override) so they can be returned by GetJob.
+
+ALTER TABLE Jobs ADD COLUMN ResourceProfile STRING(50);
+ALTER TABLE Jobs ADD COLUMN CpuMillis INT64;
+ALTER TABLE Jobs ADD COLUMN MemoryMiB INT64;
+ALTER TABLE Jobs ADD COLUMN MaxRunDurationSeconds INT64;
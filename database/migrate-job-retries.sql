@@ -0,0 +1,22 @@
+-- Migration: Add automatic retry support
+-- Description: NextAttemptAt records when a PENDING job's next retry is due,
+--              indexed so the worker reconcilers can find due retries. JobAttempts
+--              keeps one row per submission of a job to the batch provider.
+
+ALTER TABLE Jobs ADD COLUMN NextAttemptAt TIMESTAMP;
+
+CREATE NULL_FILTERED INDEX JobsByNextAttemptAt ON Jobs(NextAttemptAt);
+
+CREATE TABLE JobAttempts (
+  TenantId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  AttemptNumber INT64 NOT NULL,
+  Status STRING(50) NOT NULL,
+  ProviderJobId STRING(63),
+  CloudJobResourcePath STRING(1024),
+  FailureClass STRING(50),
+  ErrorMessage STRING(MAX),
+  StartedAt TIMESTAMP OPTIONS (allow_commit_timestamp=true),
+  EndedAt TIMESTAMP OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, JobId, AttemptNumber),
+  INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
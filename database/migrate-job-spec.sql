@@ -0,0 +1,7 @@
+-- Migration: Add JobSpec column to Jobs
+-- Description: Stores the full normalized submission (image, commands, env vars,
+--              resource profile, override and resolved resources) as a versioned
+--              JSON document so a job can be audited or reproduced later.
+--              Existing rows keep a NULL JobSpec.
+
+ALTER TABLE Jobs ADD COLUMN JobSpec JSON;
@@ -0,0 +1,6 @@
+-- Migration: Add JobsByCreatedAt index
+-- Description: ListJobs pages without a status filter read a tenant's jobs
+--              newest first from this index in one bounded range scan,
+--              instead of one JobsByStatus scan per status.
+
+CREATE INDEX JobsByCreatedAt ON Jobs(TenantId, CreatedAt DESC, JobId);
@@ -0,0 +1,8 @@
+-- Migration: Add PreemptionCount to Jobs
+-- Description: Counts how often a job was resubmitted because the provider
+--              reclaimed its VMs (e.g. spot preemption). These resubmissions
+--              are included in RetryCount but do not use up MaxRetries.
+--              Preempted attempts are recorded in JobAttempts with the
+--              status PREEMPTED.
+
+ALTER TABLE Jobs ADD COLUMN PreemptionCount INT64 NOT NULL DEFAULT (0);
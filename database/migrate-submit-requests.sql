@@ -0,0 +1,21 @@
+-- Migration: Add SubmitRequests table
+-- Description: Records the request_id of each idempotent SubmitJob call with
+--              a hash of its payload and the job it created, so a retried
+--              request returns the original job instead of creating another,
+--              along with the status or error the first call returned.
+--              Rows expire after the worker's request ID TTL and are removed
+--              by the row deletion policy.
+
+CREATE TABLE SubmitRequests (
+  TenantId STRING(36) NOT NULL,
+  RequestId STRING(128) NOT NULL,
+  PayloadHash STRING(64) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  Status STRING(50),
+  ErrorCode STRING(50),
+  ErrorMessage STRING(MAX),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  ExpiresAt TIMESTAMP NOT NULL,
+) PRIMARY KEY (TenantId, RequestId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE,
+  ROW DELETION POLICY (OLDER_THAN(ExpiresAt, INTERVAL 0 DAY));
@@ -0,0 +1,8 @@
+-- Migration: Add SubmittedJobsByStatus index
+-- Description: The worker's reconciler lists every PENDING, SCHEDULED or
+--              RUNNING job that has a CloudJobResourcePath, across all
+--              tenants. This index holds only jobs handed to a provider and
+--              lets that poll read the three status ranges instead of
+--              scanning Jobs.
+
+CREATE NULL_FILTERED INDEX SubmittedJobsByStatus ON Jobs(Status, CloudJobResourcePath);
@@ -0,0 +1,10 @@
+-- Migration: Add per-tenant data keys to Tenants
+-- Description: Env var values in Jobs.JobSpec are encrypted with a data key
+--              per tenant (envelope encryption). DataKey holds that key
+--              wrapped by the configured key-encryption key, identified by
+--              DataKeyKekId. The key is created on the tenant's first job
+--              submitted with encryption enabled; jobs stored before that
+--              keep plaintext env vars.
+
+ALTER TABLE Tenants ADD COLUMN DataKey BYTES(MAX);
+ALTER TABLE Tenants ADD COLUMN DataKeyKekId STRING(255);
@@ -0,0 +1,7 @@
+-- Migration: Add TransitionsByTenantTime index
+-- Description: Tenant-wide WatchJobs streams poll for a tenant's transitions
+--              after a (TransitionedAt, TransitionId) cursor. TransitionsByJob
+--              leads with JobId and cannot serve that range, so without
+--              this index every poll scans the tenant's whole history.
+
+CREATE INDEX TransitionsByTenantTime ON JobStateTransitions(TenantId, TransitionedAt, TransitionId);
@@ -5,6 +5,8 @@ CREATE TABLE Tenants (
   OAuthUserId STRING(255) NOT NULL,
   CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
   UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  DataKey BYTES(MAX),
+  DataKeyKekId STRING(255),
 ) PRIMARY KEY (TenantId);
 
 CREATE INDEX TenantsByOAuth ON Tenants(OAuthProvider, OAuthUserId);
@@ -24,13 +26,40 @@ CREATE TABLE Jobs (
   -- Retry and Error Handling
   RetryCount INT64 NOT NULL DEFAULT (0),
   MaxRetries INT64 NOT NULL DEFAULT (3),
+  -- Resubmissions after the provider reclaimed the job's VMs (also counted in RetryCount)
+  PreemptionCount INT64 NOT NULL DEFAULT (0),
   ErrorMessage STRING(MAX),
   CloudJobResourcePath STRING(1024),  -- Cloud provider-specific job resource identifier (GCP: projects/.../jobs/..., AWS: ARN, Azure: resource path)
+  -- Resolved Resources
+  ResourceProfile STRING(50),
+  CpuMillis INT64,
+  MemoryMiB INT64,
+  MaxRunDurationSeconds INT64,
+  -- Normalized submission, versioned JSON (see database.JobSpec)
+  JobSpec JSON,
+  -- Source job of a rerun (nullable)
+  ClonedFromJobId STRING(36),
+  -- When a PENDING job's next retry is due (nullable)
+  NextAttemptAt TIMESTAMP,
+  -- Container exit code of the finished job (nullable)
+  ExitCode INT64,
+  -- Why a FAILED job failed: IMAGE_PULL, OUT_OF_MEMORY, TIMEOUT, PREEMPTED, USER_ERROR, UNKNOWN (nullable)
+  FailureCategory STRING(50),
+  -- Last provider status events as a JSON array (nullable)
+  ProviderEvents JSON,
+  -- User labels as a JSON object, matched by ListJobs label selectors (nullable)
+  Labels JSON,
 ) PRIMARY KEY (TenantId, JobId),
   INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
 
 CREATE INDEX JobsByStatus ON Jobs(TenantId, Status, CreatedAt DESC);
 
+CREATE INDEX JobsByCreatedAt ON Jobs(TenantId, CreatedAt DESC, JobId);
+
+CREATE NULL_FILTERED INDEX JobsByNextAttemptAt ON Jobs(NextAttemptAt);
+
+CREATE NULL_FILTERED INDEX SubmittedJobsByStatus ON Jobs(Status, CloudJobResourcePath);
+
 CREATE TABLE JobStateTransitions (
   TenantId STRING(36) NOT NULL,
   JobId STRING(36) NOT NULL,
@@ -43,3 +72,39 @@ CREATE TABLE JobStateTransitions (
   INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
 
 CREATE INDEX TransitionsByJob ON JobStateTransitions(TenantId, JobId, TransitionedAt DESC);
+
+CREATE INDEX TransitionsByTenantTime ON JobStateTransitions(TenantId, TransitionedAt, TransitionId);
+
+CREATE TABLE JobAttempts (
+  TenantId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  AttemptNumber INT64 NOT NULL,
+  Status STRING(50) NOT NULL,
+  ProviderJobId STRING(63),
+  ProviderJobUid STRING(64),
+  CloudJobResourcePath STRING(1024),
+  FailureClass STRING(50),
+  ErrorMessage STRING(MAX),
+  StartedAt TIMESTAMP OPTIONS (allow_commit_timestamp=true),
+  EndedAt TIMESTAMP OPTIONS (allow_commit_timestamp=true),
+  ExitCode INT64,
+  FailureCategory STRING(50),
+  ProviderEvents JSON,
+) PRIMARY KEY (TenantId, JobId, AttemptNumber),
+  INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
+
+CREATE TABLE SubmitRequests (
+  TenantId STRING(36) NOT NULL,
+  RequestId STRING(128) NOT NULL,
+  PayloadHash STRING(64) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  -- Status returned by the first SubmitJob call (nullable until recorded)
+  Status STRING(50),
+  -- Error returned instead, if the first call failed after creating the job
+  ErrorCode STRING(50),
+  ErrorMessage STRING(MAX),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  ExpiresAt TIMESTAMP NOT NULL,
+) PRIMARY KEY (TenantId, RequestId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE,
+  ROW DELETION POLICY (OLDER_THAN(ExpiresAt, INTERVAL 0 DAY));
@@ -87,17 +87,70 @@ func (x *ResourceOverride) GetMaxRunDurationSeconds() int64 {
 }
 
 type SubmitJobRequest struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	ImageUri string                 `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
-	EnvVars  map[string]string      `protobuf:"bytes,3,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Example: { "DB_HOST": "10.0.0.1", "DEBUG": "true" }
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// image_uri is the container image to run. Exactly one of image_uri, script
+	// and steps must be set.
+	ImageUri string            `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	EnvVars  map[string]string `protobuf:"bytes,3,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Example: { "DB_HOST": "10.0.0.1", "DEBUG": "true" }
 	// resource_profile selects a named preset: "small", "medium", "large", "xlarge".
 	// Defaults to "medium" when empty.
 	ResourceProfile string `protobuf:"bytes,4,opt,name=resource_profile,json=resourceProfile,proto3" json:"resource_profile,omitempty"`
 	// resource_override provides inline resource values that take precedence over the preset.
 	// Partial overrides are supported — zero fields fall back to the resolved preset.
 	ResourceOverride *ResourceOverride `protobuf:"bytes,5,opt,name=resource_override,json=resourceOverride,proto3" json:"resource_override,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// retry_policy resubmits the job automatically when it fails. Unset means no retries.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,6,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// lifecycle_policies tell the batch provider, by container exit code, whether
+	// a failed task is retried in place or failed immediately.
+	LifecyclePolicies []*LifecyclePolicy `protobuf:"bytes,7,rep,name=lifecycle_policies,json=lifecyclePolicies,proto3" json:"lifecycle_policies,omitempty"`
+	// max_task_retries is how many times the provider may retry a failed task
+	// (0-10). Defaults to 3 when lifecycle_policies are set, otherwise 0.
+	MaxTaskRetries int32 `protobuf:"varint,8,opt,name=max_task_retries,json=maxTaskRetries,proto3" json:"max_task_retries,omitempty"`
+	// commands replace the image's CMD, i.e. the arguments passed to the entrypoint.
+	Commands []string `protobuf:"bytes,9,rep,name=commands,proto3" json:"commands,omitempty"`
+	// entrypoint replaces the image's ENTRYPOINT when set.
+	Entrypoint string `protobuf:"bytes,10,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	// container_options change how the container is run (optional).
+	ContainerOptions *ContainerOptions `protobuf:"bytes,11,opt,name=container_options,json=containerOptions,proto3" json:"container_options,omitempty"`
+	// script runs inline shell text on the host VM instead of a container image.
+	// commands, entrypoint and container_options do not apply to scripts.
+	Script *Script `protobuf:"bytes,12,opt,name=script,proto3" json:"script,omitempty"`
+	// steps run in order within the job, e.g. setup, main work and teardown.
+	// env_vars apply to every step. At most 32 steps.
+	Steps []*Step `protobuf:"bytes,13,rep,name=steps,proto3" json:"steps,omitempty"`
+	// task_count fans the job out into this many identical tasks (1-10000,
+	// default 1). Each task sees its index in BATCH_TASK_INDEX.
+	TaskCount int64 `protobuf:"varint,14,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	// parallelism caps how many tasks run at once. 0 leaves it to the provider.
+	Parallelism int64 `protobuf:"varint,15,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	// volumes are mounted into every runnable of the job. At most 16.
+	Volumes []*Volume `protobuf:"bytes,16,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	// labels are set on the provider job along with the jennah-tenant-id and
+	// jennah-job-id system labels. Keys are 1-63 lowercase letters, digits, _ or
+	// -, starting with a letter and not with "jennah-"; values are at most 63 of
+	// the same characters. At most 62 labels.
+	Labels map[string]string `protobuf:"bytes,17,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// priority orders the job against other queued jobs, 0 (lowest) to 99.
+	// 0 uses the resource profile's priority.
+	Priority int64 `protobuf:"varint,18,opt,name=priority,proto3" json:"priority,omitempty"`
+	// allocation chooses the VMs the job runs on. Unset fields use the resource
+	// profile's values.
+	Allocation *Allocation `protobuf:"bytes,19,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	// preemption_policy resubmits the job when the provider reclaims its VMs.
+	// Unset allows 3 resubmissions without fallback.
+	PreemptionPolicy *PreemptionPolicy `protobuf:"bytes,20,opt,name=preemption_policy,json=preemptionPolicy,proto3" json:"preemption_policy,omitempty"`
+	// secret_env_vars set environment variables from secrets managed outside
+	// Jennah. Only the references are sent and stored; the provider reads the
+	// values when the job starts. Keys may not repeat env_vars keys.
+	SecretEnvVars map[string]*SecretEnvVar `protobuf:"bytes,21,rep,name=secret_env_vars,json=secretEnvVars,proto3" json:"secret_env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// request_id makes the submission idempotent for the worker's request ID
+	// TTL (24 hours by default): a repeat with the same request_id and payload
+	// returns the original response instead of creating another job, and a
+	// repeat with a different payload fails with AlreadyExists. Up to 128
+	// letters, digits, ".", "_", ":" and "-".
+	RequestId     string `protobuf:"bytes,22,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitJobRequest) Reset() {
@@ -158,29 +211,151 @@ func (x *SubmitJobRequest) GetResourceOverride() *ResourceOverride {
 	return nil
 }
 
-type SubmitJobResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	JobId          string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	WorkerAssigned string                 `protobuf:"bytes,3,opt,name=worker_assigned,json=workerAssigned,proto3" json:"worker_assigned,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SubmitJobRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
 }
 
-func (x *SubmitJobResponse) Reset() {
-	*x = SubmitJobResponse{}
+func (x *SubmitJobRequest) GetLifecyclePolicies() []*LifecyclePolicy {
+	if x != nil {
+		return x.LifecyclePolicies
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetMaxTaskRetries() int32 {
+	if x != nil {
+		return x.MaxTaskRetries
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetEntrypoint() string {
+	if x != nil {
+		return x.Entrypoint
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetContainerOptions() *ContainerOptions {
+	if x != nil {
+		return x.ContainerOptions
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetScript() *Script {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetSteps() []*Step {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetParallelism() int64 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetAllocation() *Allocation {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetPreemptionPolicy() *PreemptionPolicy {
+	if x != nil {
+		return x.PreemptionPolicy
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetSecretEnvVars() map[string]*SecretEnvVar {
+	if x != nil {
+		return x.SecretEnvVars
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// SecretEnvVar references one version of a secret, e.g. a Secret Manager
+// secret on GCP.
+type SecretEnvVar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// secret is the secret's name: letters, digits, "_" and "-".
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// version is a version number or "latest" (default).
+	Version       string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretEnvVar) Reset() {
+	*x = SecretEnvVar{}
 	mi := &file_proto_jennah_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitJobResponse) String() string {
+func (x *SecretEnvVar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitJobResponse) ProtoMessage() {}
+func (*SecretEnvVar) ProtoMessage() {}
 
-func (x *SubmitJobResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretEnvVar) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_jennah_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -192,52 +367,52 @@ func (x *SubmitJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitJobResponse.ProtoReflect.Descriptor instead.
-func (*SubmitJobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretEnvVar.ProtoReflect.Descriptor instead.
+func (*SecretEnvVar) Descriptor() ([]byte, []int) {
 	return file_proto_jennah_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *SubmitJobResponse) GetJobId() string {
-	if x != nil {
-		return x.JobId
-	}
-	return ""
-}
-
-func (x *SubmitJobResponse) GetStatus() string {
+func (x *SecretEnvVar) GetSecret() string {
 	if x != nil {
-		return x.Status
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *SubmitJobResponse) GetWorkerAssigned() string {
+func (x *SecretEnvVar) GetVersion() string {
 	if x != nil {
-		return x.WorkerAssigned
+		return x.Version
 	}
 	return ""
 }
 
-type ListJobsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// PreemptionPolicy controls resubmission of preempted jobs. Preemptions do
+// not use up retry_policy attempts.
+type PreemptionPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_retries is how many times a preempted job is resubmitted (0-10).
+	MaxRetries int64 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// fallback_to_standard runs one more attempt on STANDARD VMs once a SPOT
+	// job has used max_retries.
+	FallbackToStandard bool `protobuf:"varint,2,opt,name=fallback_to_standard,json=fallbackToStandard,proto3" json:"fallback_to_standard,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListJobsRequest) Reset() {
-	*x = ListJobsRequest{}
+func (x *PreemptionPolicy) Reset() {
+	*x = PreemptionPolicy{}
 	mi := &file_proto_jennah_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJobsRequest) String() string {
+func (x *PreemptionPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobsRequest) ProtoMessage() {}
+func (*PreemptionPolicy) ProtoMessage() {}
 
-func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *PreemptionPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_jennah_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -249,32 +424,55 @@ func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
-func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreemptionPolicy.ProtoReflect.Descriptor instead.
+func (*PreemptionPolicy) Descriptor() ([]byte, []int) {
 	return file_proto_jennah_proto_rawDescGZIP(), []int{3}
 }
 
-type ListJobsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+func (x *PreemptionPolicy) GetMaxRetries() int64 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *PreemptionPolicy) GetFallbackToStandard() bool {
+	if x != nil {
+		return x.FallbackToStandard
+	}
+	return false
+}
+
+// Allocation chooses the VMs a job runs on.
+type Allocation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// machine_type is a machine type such as "n2-standard-4" or a machine
+	// family such as "n2".
+	MachineType string `protobuf:"bytes,1,opt,name=machine_type,json=machineType,proto3" json:"machine_type,omitempty"`
+	// provisioning_model is "STANDARD" or "SPOT". Spot VMs are cheaper but may
+	// be reclaimed while the job runs.
+	ProvisioningModel string `protobuf:"bytes,2,opt,name=provisioning_model,json=provisioningModel,proto3" json:"provisioning_model,omitempty"`
+	// zones limits the job to these zones of the provider's region, e.g.
+	// "asia-northeast1-a".
+	Zones         []string `protobuf:"bytes,3,rep,name=zones,proto3" json:"zones,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJobsResponse) Reset() {
-	*x = ListJobsResponse{}
+func (x *Allocation) Reset() {
+	*x = Allocation{}
 	mi := &file_proto_jennah_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJobsResponse) String() string {
+func (x *Allocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobsResponse) ProtoMessage() {}
+func (*Allocation) ProtoMessage() {}
 
-func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *Allocation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_jennah_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,43 +484,62 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
-func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Allocation.ProtoReflect.Descriptor instead.
+func (*Allocation) Descriptor() ([]byte, []int) {
 	return file_proto_jennah_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListJobsResponse) GetJobs() []*Job {
+func (x *Allocation) GetMachineType() string {
 	if x != nil {
-		return x.Jobs
+		return x.MachineType
+	}
+	return ""
+}
+
+func (x *Allocation) GetProvisioningModel() string {
+	if x != nil {
+		return x.ProvisioningModel
+	}
+	return ""
+}
+
+func (x *Allocation) GetZones() []string {
+	if x != nil {
+		return x.Zones
 	}
 	return nil
 }
 
-type Job struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	ImageUri      string                 `protobuf:"bytes,3,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+// Volume is storage mounted at mount_path. Exactly one of gcs, nfs and disk
+// is set.
+type Volume struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// mount_path is an absolute path that may not contain or sit inside
+	// another volume's mount_path.
+	MountPath string `protobuf:"bytes,1,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	// read_only mounts a gcs or nfs volume read-only.
+	ReadOnly      bool        `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	Gcs           *GcsVolume  `protobuf:"bytes,3,opt,name=gcs,proto3" json:"gcs,omitempty"`
+	Nfs           *NfsVolume  `protobuf:"bytes,4,opt,name=nfs,proto3" json:"nfs,omitempty"`
+	Disk          *DiskVolume `protobuf:"bytes,5,opt,name=disk,proto3" json:"disk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Job) Reset() {
-	*x = Job{}
+func (x *Volume) Reset() {
+	*x = Volume{}
 	mi := &file_proto_jennah_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Job) String() string {
+func (x *Volume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Job) ProtoMessage() {}
+func (*Volume) ProtoMessage() {}
 
-func (x *Job) ProtoReflect() protoreflect.Message {
+func (x *Volume) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_jennah_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -334,66 +551,71 @@ func (x *Job) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Job.ProtoReflect.Descriptor instead.
-func (*Job) Descriptor() ([]byte, []int) {
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
 	return file_proto_jennah_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Job) GetJobId() string {
+func (x *Volume) GetMountPath() string {
 	if x != nil {
-		return x.JobId
+		return x.MountPath
 	}
 	return ""
 }
 
-func (x *Job) GetTenantId() string {
+func (x *Volume) GetReadOnly() bool {
 	if x != nil {
-		return x.TenantId
+		return x.ReadOnly
 	}
-	return ""
+	return false
 }
 
-func (x *Job) GetImageUri() string {
+func (x *Volume) GetGcs() *GcsVolume {
 	if x != nil {
-		return x.ImageUri
+		return x.Gcs
 	}
-	return ""
+	return nil
 }
 
-func (x *Job) GetStatus() string {
+func (x *Volume) GetNfs() *NfsVolume {
 	if x != nil {
-		return x.Status
+		return x.Nfs
 	}
-	return ""
+	return nil
 }
 
-func (x *Job) GetCreatedAt() string {
+func (x *Volume) GetDisk() *DiskVolume {
 	if x != nil {
-		return x.CreatedAt
+		return x.Disk
 	}
-	return ""
+	return nil
 }
 
-type GetCurrentTenantRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// GcsVolume mounts a Cloud Storage bucket, or a folder of it.
+type GcsVolume struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bucket is the bucket name, without "gs://".
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// path is an optional folder within the bucket.
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentTenantRequest) Reset() {
-	*x = GetCurrentTenantRequest{}
+func (x *GcsVolume) Reset() {
+	*x = GcsVolume{}
 	mi := &file_proto_jennah_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentTenantRequest) String() string {
+func (x *GcsVolume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentTenantRequest) ProtoMessage() {}
+func (*GcsVolume) ProtoMessage() {}
 
-func (x *GetCurrentTenantRequest) ProtoReflect() protoreflect.Message {
+func (x *GcsVolume) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_jennah_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,35 +627,50 @@ func (x *GetCurrentTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentTenantRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentTenantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GcsVolume.ProtoReflect.Descriptor instead.
+func (*GcsVolume) Descriptor() ([]byte, []int) {
 	return file_proto_jennah_proto_rawDescGZIP(), []int{6}
 }
 
-type GetCurrentTenantResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	OauthProvider string                 `protobuf:"bytes,3,opt,name=oauth_provider,json=oauthProvider,proto3" json:"oauth_provider,omitempty"` // "google", "github"
-	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *GcsVolume) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *GcsVolume) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// NfsVolume mounts a directory exported by an NFS server.
+type NfsVolume struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// server is the NFS server's host name or IP address.
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// remote_path is the absolute exported directory.
+	RemotePath    string `protobuf:"bytes,2,opt,name=remote_path,json=remotePath,proto3" json:"remote_path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentTenantResponse) Reset() {
-	*x = GetCurrentTenantResponse{}
+func (x *NfsVolume) Reset() {
+	*x = NfsVolume{}
 	mi := &file_proto_jennah_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentTenantResponse) String() string {
+func (x *NfsVolume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentTenantResponse) ProtoMessage() {}
+func (*NfsVolume) ProtoMessage() {}
 
-func (x *GetCurrentTenantResponse) ProtoReflect() protoreflect.Message {
+func (x *NfsVolume) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_jennah_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -445,60 +682,51 @@ func (x *GetCurrentTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentTenantResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentTenantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NfsVolume.ProtoReflect.Descriptor instead.
+func (*NfsVolume) Descriptor() ([]byte, []int) {
 	return file_proto_jennah_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetCurrentTenantResponse) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *GetCurrentTenantResponse) GetUserEmail() string {
-	if x != nil {
-		return x.UserEmail
-	}
-	return ""
-}
-
-func (x *GetCurrentTenantResponse) GetOauthProvider() string {
+func (x *NfsVolume) GetServer() string {
 	if x != nil {
-		return x.OauthProvider
+		return x.Server
 	}
 	return ""
 }
 
-func (x *GetCurrentTenantResponse) GetCreatedAt() string {
+func (x *NfsVolume) GetRemotePath() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RemotePath
 	}
 	return ""
 }
 
-type CancelJobRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+// DiskVolume is an empty disk created for each task and deleted with it.
+type DiskVolume struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// size_gb is the disk size (1-65536). local-ssd disks are sized in
+	// multiples of 375.
+	SizeGb int64 `protobuf:"varint,1,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	// type is "pd-balanced" (default), "pd-standard", "pd-ssd" or "local-ssd".
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelJobRequest) Reset() {
-	*x = CancelJobRequest{}
+func (x *DiskVolume) Reset() {
+	*x = DiskVolume{}
 	mi := &file_proto_jennah_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelJobRequest) String() string {
+func (x *DiskVolume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelJobRequest) ProtoMessage() {}
+func (*DiskVolume) ProtoMessage() {}
 
-func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+func (x *DiskVolume) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_jennah_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -510,41 +738,2471 @@ func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
-func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskVolume.ProtoReflect.Descriptor instead.
+func (*DiskVolume) Descriptor() ([]byte, []int) {
 	return file_proto_jennah_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CancelJobRequest) GetJobId() string {
+func (x *DiskVolume) GetSizeGb() int64 {
 	if x != nil {
-		return x.JobId
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *DiskVolume) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// Step is one runnable of a multi-step job. Exactly one of image_uri, script
+// and barrier is set.
+type Step struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name labels the step in provider logs and names a barrier (optional).
+	Name             string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUri         string            `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Commands         []string          `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	Entrypoint       string            `protobuf:"bytes,4,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	ContainerOptions *ContainerOptions `protobuf:"bytes,5,opt,name=container_options,json=containerOptions,proto3" json:"container_options,omitempty"`
+	Script           *Script           `protobuf:"bytes,6,opt,name=script,proto3" json:"script,omitempty"`
+	// barrier makes the step wait until every task of the job reaches it.
+	Barrier bool `protobuf:"varint,7,opt,name=barrier,proto3" json:"barrier,omitempty"`
+	// env_vars are merged over the job's env_vars for this step only.
+	EnvVars map[string]string `protobuf:"bytes,8,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// background starts the step without waiting for it to finish. It is
+	// stopped once the foreground steps are done.
+	Background bool `protobuf:"varint,9,opt,name=background,proto3" json:"background,omitempty"`
+	// ignore_exit_status lets the job continue when the step fails.
+	IgnoreExitStatus bool `protobuf:"varint,10,opt,name=ignore_exit_status,json=ignoreExitStatus,proto3" json:"ignore_exit_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Step) Reset() {
+	*x = Step{}
+	mi := &file_proto_jennah_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Step) ProtoMessage() {}
+
+func (x *Step) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Step.ProtoReflect.Descriptor instead.
+func (*Step) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Step) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Step) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *Step) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *Step) GetEntrypoint() string {
+	if x != nil {
+		return x.Entrypoint
+	}
+	return ""
+}
+
+func (x *Step) GetContainerOptions() *ContainerOptions {
+	if x != nil {
+		return x.ContainerOptions
+	}
+	return nil
+}
+
+func (x *Step) GetScript() *Script {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *Step) GetBarrier() bool {
+	if x != nil {
+		return x.Barrier
+	}
+	return false
+}
+
+func (x *Step) GetEnvVars() map[string]string {
+	if x != nil {
+		return x.EnvVars
+	}
+	return nil
+}
+
+func (x *Step) GetBackground() bool {
+	if x != nil {
+		return x.Background
+	}
+	return false
+}
+
+func (x *Step) GetIgnoreExitStatus() bool {
+	if x != nil {
+		return x.IgnoreExitStatus
+	}
+	return false
+}
+
+// Script is a runnable made of inline text, used instead of a container image.
+type Script struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// text is the script body, at most 64 KiB.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// interpreter is an absolute path, optionally with arguments, e.g. "/bin/bash"
+	// or "/usr/bin/env python3". Empty uses the script's own "#!" line, or /bin/sh.
+	Interpreter   string `protobuf:"bytes,2,opt,name=interpreter,proto3" json:"interpreter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Script) Reset() {
+	*x = Script{}
+	mi := &file_proto_jennah_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Script) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Script) ProtoMessage() {}
+
+func (x *Script) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Script.ProtoReflect.Descriptor instead.
+func (*Script) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Script) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Script) GetInterpreter() string {
+	if x != nil {
+		return x.Interpreter
+	}
+	return ""
+}
+
+// ContainerOptions change how a job's container is run.
+type ContainerOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// working_dir is the absolute directory the command runs in.
+	WorkingDir string `protobuf:"bytes,1,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
+	// user runs the container as "name", "uid" or "uid:gid".
+	User          string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerOptions) Reset() {
+	*x = ContainerOptions{}
+	mi := &file_proto_jennah_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerOptions) ProtoMessage() {}
+
+func (x *ContainerOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerOptions.ProtoReflect.Descriptor instead.
+func (*ContainerOptions) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ContainerOptions) GetWorkingDir() string {
+	if x != nil {
+		return x.WorkingDir
+	}
+	return ""
+}
+
+func (x *ContainerOptions) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+// LifecyclePolicy maps container exit codes to a provider action.
+type LifecyclePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// action is "RETRY_TASK" (retry matching exit codes, fail the rest) or
+	// "FAIL_TASK" (fail matching exit codes, retry the rest).
+	Action        string  `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ExitCodes     []int32 `protobuf:"varint,2,rep,packed,name=exit_codes,json=exitCodes,proto3" json:"exit_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LifecyclePolicy) Reset() {
+	*x = LifecyclePolicy{}
+	mi := &file_proto_jennah_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LifecyclePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecyclePolicy) ProtoMessage() {}
+
+func (x *LifecyclePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecyclePolicy.ProtoReflect.Descriptor instead.
+func (*LifecyclePolicy) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LifecyclePolicy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LifecyclePolicy) GetExitCodes() []int32 {
+	if x != nil {
+		return x.ExitCodes
+	}
+	return nil
+}
+
+// RetryPolicy controls automatic resubmission of a failed job.
+type RetryPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_attempts counts the first run, so 3 allows two retries. Must be 1-10.
+	MaxAttempts int64 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// initial_backoff_seconds is the delay before the first retry. Defaults to 30.
+	InitialBackoffSeconds int64 `protobuf:"varint,2,opt,name=initial_backoff_seconds,json=initialBackoffSeconds,proto3" json:"initial_backoff_seconds,omitempty"`
+	// max_backoff_seconds caps the delay between retries. Defaults to 600.
+	MaxBackoffSeconds int64 `protobuf:"varint,3,opt,name=max_backoff_seconds,json=maxBackoffSeconds,proto3" json:"max_backoff_seconds,omitempty"`
+	// backoff_multiplier grows the delay after each retry. Defaults to 2.
+	BackoffMultiplier float64 `protobuf:"fixed64,4,opt,name=backoff_multiplier,json=backoffMultiplier,proto3" json:"backoff_multiplier,omitempty"`
+	// retry_on selects which failures are retried: "SUBMISSION" (the provider
+	// rejected the job) and/or "RUNTIME" (the job failed while running).
+	// Empty means both.
+	RetryOn       []string `protobuf:"bytes,5,rep,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_proto_jennah_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int64 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetInitialBackoffSeconds() int64 {
+	if x != nil {
+		return x.InitialBackoffSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxBackoffSeconds() int64 {
+	if x != nil {
+		return x.MaxBackoffSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffMultiplier() float64 {
+	if x != nil {
+		return x.BackoffMultiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryOn() []string {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
+type SubmitJobResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobId          string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	WorkerAssigned string                 `protobuf:"bytes,3,opt,name=worker_assigned,json=workerAssigned,proto3" json:"worker_assigned,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitJobResponse) Reset() {
+	*x = SubmitJobResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobResponse) ProtoMessage() {}
+
+func (x *SubmitJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobResponse.ProtoReflect.Descriptor instead.
+func (*SubmitJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SubmitJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *SubmitJobResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SubmitJobResponse) GetWorkerAssigned() string {
+	if x != nil {
+		return x.WorkerAssigned
+	}
+	return ""
+}
+
+type RerunJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_id is the job to clone. It must have a stored spec.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// env_vars are merged over the source job's env vars. Secret env vars are
+	// copied from the source job and may not be overridden.
+	EnvVars map[string]string `protobuf:"bytes,2,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// resource_profile replaces the source profile when set. Unless a new
+	// resource_override is also given, the source override is dropped so the
+	// new profile takes full effect.
+	ResourceProfile string `protobuf:"bytes,3,opt,name=resource_profile,json=resourceProfile,proto3" json:"resource_profile,omitempty"`
+	// resource_override replaces the source override when set.
+	ResourceOverride *ResourceOverride `protobuf:"bytes,4,opt,name=resource_override,json=resourceOverride,proto3" json:"resource_override,omitempty"`
+	// labels are merged over the source job's labels.
+	Labels        map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RerunJobRequest) Reset() {
+	*x = RerunJobRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerunJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunJobRequest) ProtoMessage() {}
+
+func (x *RerunJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunJobRequest.ProtoReflect.Descriptor instead.
+func (*RerunJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RerunJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *RerunJobRequest) GetEnvVars() map[string]string {
+	if x != nil {
+		return x.EnvVars
+	}
+	return nil
+}
+
+func (x *RerunJobRequest) GetResourceProfile() string {
+	if x != nil {
+		return x.ResourceProfile
+	}
+	return ""
+}
+
+func (x *RerunJobRequest) GetResourceOverride() *ResourceOverride {
+	if x != nil {
+		return x.ResourceOverride
+	}
+	return nil
+}
+
+func (x *RerunJobRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type RerunJobResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JobId           string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status          string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	WorkerAssigned  string                 `protobuf:"bytes,3,opt,name=worker_assigned,json=workerAssigned,proto3" json:"worker_assigned,omitempty"`
+	ClonedFromJobId string                 `protobuf:"bytes,4,opt,name=cloned_from_job_id,json=clonedFromJobId,proto3" json:"cloned_from_job_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RerunJobResponse) Reset() {
+	*x = RerunJobResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerunJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunJobResponse) ProtoMessage() {}
+
+func (x *RerunJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunJobResponse.ProtoReflect.Descriptor instead.
+func (*RerunJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RerunJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *RerunJobResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RerunJobResponse) GetWorkerAssigned() string {
+	if x != nil {
+		return x.WorkerAssigned
+	}
+	return ""
+}
+
+func (x *RerunJobResponse) GetClonedFromJobId() string {
+	if x != nil {
+		return x.ClonedFromJobId
+	}
+	return ""
+}
+
+type ListJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size is the maximum number of jobs to return. 0 means 100; the maximum is 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is next_page_token from a previous call made with the same filters.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// statuses keeps only jobs in one of these statuses. Empty matches every status.
+	Statuses []string `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// created_after keeps jobs created at or after this RFC 3339 time.
+	CreatedAfter string `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// created_before keeps jobs created before this RFC 3339 time.
+	CreatedBefore string `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// image_uri_prefix keeps jobs whose image URI starts with this prefix.
+	ImageUriPrefix string `protobuf:"bytes,6,opt,name=image_uri_prefix,json=imageUriPrefix,proto3" json:"image_uri_prefix,omitempty"`
+	// label_selectors keeps jobs that have every given label, each written as
+	// "key=value". One selector may hold several, separated by commas.
+	LabelSelectors []string `protobuf:"bytes,7,rep,name=label_selectors,json=labelSelectors,proto3" json:"label_selectors,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListJobsRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetImageUriPrefix() string {
+	if x != nil {
+		return x.ImageUriPrefix
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetLabelSelectors() []string {
+	if x != nil {
+		return x.LabelSelectors
+	}
+	return nil
+}
+
+type ListJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Jobs  []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// next_page_token fetches the following page. Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Job struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JobId     string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TenantId  string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ImageUri  string                 `protobuf:"bytes,3,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Lifecycle timestamps (RFC 3339). Empty until the job reaches that stage.
+	ScheduledAt  string `protobuf:"bytes,7,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	StartedAt    string `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt  string `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	RetryCount   int64  `protobuf:"varint,10,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	MaxRetries   int64  `protobuf:"varint,11,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	ErrorMessage string `protobuf:"bytes,12,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// cloud_job_resource_path is the provider-specific job identifier (GCP: projects/.../jobs/..., AWS: ARN).
+	CloudJobResourcePath string   `protobuf:"bytes,13,opt,name=cloud_job_resource_path,json=cloudJobResourcePath,proto3" json:"cloud_job_resource_path,omitempty"`
+	Commands             []string `protobuf:"bytes,14,rep,name=commands,proto3" json:"commands,omitempty"`
+	// resource_profile is the preset requested at submission; empty means the default.
+	ResourceProfile string `protobuf:"bytes,15,opt,name=resource_profile,json=resourceProfile,proto3" json:"resource_profile,omitempty"`
+	// resources are the compute resources resolved at submission (preset merged with any override).
+	Resources *JobResources `protobuf:"bytes,16,opt,name=resources,proto3" json:"resources,omitempty"`
+	// spec is the normalized submission the job was created from. Unset for jobs
+	// created before specs were stored.
+	Spec *JobSpec `protobuf:"bytes,17,opt,name=spec,proto3" json:"spec,omitempty"`
+	// cloned_from_job_id is the job this one was rerun from, if any.
+	ClonedFromJobId string `protobuf:"bytes,18,opt,name=cloned_from_job_id,json=clonedFromJobId,proto3" json:"cloned_from_job_id,omitempty"`
+	// next_attempt_at is when a PENDING job's next retry will be submitted (RFC 3339).
+	NextAttemptAt string `protobuf:"bytes,19,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	// exit_code is the container exit code of the finished job, when the provider reported one.
+	ExitCode *int32 `protobuf:"varint,20,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	// failure_category classifies why a FAILED job failed: "IMAGE_PULL",
+	// "OUT_OF_MEMORY", "TIMEOUT", "PREEMPTED", "USER_ERROR" or "UNKNOWN".
+	FailureCategory string `protobuf:"bytes,21,opt,name=failure_category,json=failureCategory,proto3" json:"failure_category,omitempty"`
+	// provider_events are the batch provider's last status events for the job, oldest first.
+	ProviderEvents []*ProviderEvent  `protobuf:"bytes,22,rep,name=provider_events,json=providerEvents,proto3" json:"provider_events,omitempty"`
+	Labels         map[string]string `protobuf:"bytes,23,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// preemption_count is how many of retry_count resubmissions followed a
+	// preemption.
+	PreemptionCount int64 `protobuf:"varint,24,opt,name=preemption_count,json=preemptionCount,proto3" json:"preemption_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_proto_jennah_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Job) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Job) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Job) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *Job) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Job) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Job) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Job) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+func (x *Job) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Job) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *Job) GetRetryCount() int64 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *Job) GetMaxRetries() int64 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *Job) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *Job) GetCloudJobResourcePath() string {
+	if x != nil {
+		return x.CloudJobResourcePath
+	}
+	return ""
+}
+
+func (x *Job) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *Job) GetResourceProfile() string {
+	if x != nil {
+		return x.ResourceProfile
+	}
+	return ""
+}
+
+func (x *Job) GetResources() *JobResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *Job) GetSpec() *JobSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Job) GetClonedFromJobId() string {
+	if x != nil {
+		return x.ClonedFromJobId
+	}
+	return ""
+}
+
+func (x *Job) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *Job) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *Job) GetFailureCategory() string {
+	if x != nil {
+		return x.FailureCategory
+	}
+	return ""
+}
+
+func (x *Job) GetProviderEvents() []*ProviderEvent {
+	if x != nil {
+		return x.ProviderEvents
+	}
+	return nil
+}
+
+func (x *Job) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Job) GetPreemptionCount() int64 {
+	if x != nil {
+		return x.PreemptionCount
+	}
+	return 0
+}
+
+// ProviderEvent is one status event reported by the batch provider.
+type ProviderEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventTime     string                 `protobuf:"bytes,1,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderEvent) Reset() {
+	*x = ProviderEvent{}
+	mi := &file_proto_jennah_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderEvent) ProtoMessage() {}
+
+func (x *ProviderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderEvent.ProtoReflect.Descriptor instead.
+func (*ProviderEvent) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProviderEvent) GetEventTime() string {
+	if x != nil {
+		return x.EventTime
+	}
+	return ""
+}
+
+func (x *ProviderEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProviderEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// JobSpec is the versioned, normalized form of a job submission.
+type JobSpec struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Version  int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ImageUri string                 `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Commands []string               `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	// env_vars (and step env_vars) values are "<redacted>" unless revealed
+	// through GetJob.
+	EnvVars         map[string]string `protobuf:"bytes,4,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResourceProfile string            `protobuf:"bytes,5,opt,name=resource_profile,json=resourceProfile,proto3" json:"resource_profile,omitempty"`
+	// resource_override is the override exactly as submitted, if any.
+	ResourceOverride *ResourceOverride `protobuf:"bytes,6,opt,name=resource_override,json=resourceOverride,proto3" json:"resource_override,omitempty"`
+	// resources are the values sent to the batch provider.
+	Resources         *JobResources      `protobuf:"bytes,7,opt,name=resources,proto3" json:"resources,omitempty"`
+	RetryPolicy       *RetryPolicy       `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	LifecyclePolicies []*LifecyclePolicy `protobuf:"bytes,9,rep,name=lifecycle_policies,json=lifecyclePolicies,proto3" json:"lifecycle_policies,omitempty"`
+	MaxTaskRetries    int32              `protobuf:"varint,10,opt,name=max_task_retries,json=maxTaskRetries,proto3" json:"max_task_retries,omitempty"`
+	Entrypoint        string             `protobuf:"bytes,11,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	ContainerOptions  *ContainerOptions  `protobuf:"bytes,12,opt,name=container_options,json=containerOptions,proto3" json:"container_options,omitempty"`
+	Script            *Script            `protobuf:"bytes,13,opt,name=script,proto3" json:"script,omitempty"`
+	Steps             []*Step            `protobuf:"bytes,14,rep,name=steps,proto3" json:"steps,omitempty"`
+	TaskCount         int64              `protobuf:"varint,15,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	Parallelism       int64              `protobuf:"varint,16,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	Volumes           []*Volume          `protobuf:"bytes,17,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Labels            map[string]string  `protobuf:"bytes,18,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// priority and allocation are the resolved values sent to the provider.
+	Priority         int64                    `protobuf:"varint,19,opt,name=priority,proto3" json:"priority,omitempty"`
+	Allocation       *Allocation              `protobuf:"bytes,20,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	PreemptionPolicy *PreemptionPolicy        `protobuf:"bytes,21,opt,name=preemption_policy,json=preemptionPolicy,proto3" json:"preemption_policy,omitempty"`
+	SecretEnvVars    map[string]*SecretEnvVar `protobuf:"bytes,22,rep,name=secret_env_vars,json=secretEnvVars,proto3" json:"secret_env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JobSpec) Reset() {
+	*x = JobSpec{}
+	mi := &file_proto_jennah_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSpec) ProtoMessage() {}
+
+func (x *JobSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSpec.ProtoReflect.Descriptor instead.
+func (*JobSpec) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *JobSpec) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *JobSpec) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *JobSpec) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *JobSpec) GetEnvVars() map[string]string {
+	if x != nil {
+		return x.EnvVars
+	}
+	return nil
+}
+
+func (x *JobSpec) GetResourceProfile() string {
+	if x != nil {
+		return x.ResourceProfile
+	}
+	return ""
+}
+
+func (x *JobSpec) GetResourceOverride() *ResourceOverride {
+	if x != nil {
+		return x.ResourceOverride
+	}
+	return nil
+}
+
+func (x *JobSpec) GetResources() *JobResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *JobSpec) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *JobSpec) GetLifecyclePolicies() []*LifecyclePolicy {
+	if x != nil {
+		return x.LifecyclePolicies
+	}
+	return nil
+}
+
+func (x *JobSpec) GetMaxTaskRetries() int32 {
+	if x != nil {
+		return x.MaxTaskRetries
+	}
+	return 0
+}
+
+func (x *JobSpec) GetEntrypoint() string {
+	if x != nil {
+		return x.Entrypoint
+	}
+	return ""
+}
+
+func (x *JobSpec) GetContainerOptions() *ContainerOptions {
+	if x != nil {
+		return x.ContainerOptions
+	}
+	return nil
+}
+
+func (x *JobSpec) GetScript() *Script {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *JobSpec) GetSteps() []*Step {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *JobSpec) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *JobSpec) GetParallelism() int64 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *JobSpec) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *JobSpec) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *JobSpec) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *JobSpec) GetAllocation() *Allocation {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+func (x *JobSpec) GetPreemptionPolicy() *PreemptionPolicy {
+	if x != nil {
+		return x.PreemptionPolicy
+	}
+	return nil
+}
+
+func (x *JobSpec) GetSecretEnvVars() map[string]*SecretEnvVar {
+	if x != nil {
+		return x.SecretEnvVars
+	}
+	return nil
+}
+
+// JobResources are the compute resources a job was submitted with.
+type JobResources struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CpuMillis             int64                  `protobuf:"varint,1,opt,name=cpu_millis,json=cpuMillis,proto3" json:"cpu_millis,omitempty"`
+	MemoryMib             int64                  `protobuf:"varint,2,opt,name=memory_mib,json=memoryMib,proto3" json:"memory_mib,omitempty"`
+	MaxRunDurationSeconds int64                  `protobuf:"varint,3,opt,name=max_run_duration_seconds,json=maxRunDurationSeconds,proto3" json:"max_run_duration_seconds,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *JobResources) Reset() {
+	*x = JobResources{}
+	mi := &file_proto_jennah_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResources) ProtoMessage() {}
+
+func (x *JobResources) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResources.ProtoReflect.Descriptor instead.
+func (*JobResources) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *JobResources) GetCpuMillis() int64 {
+	if x != nil {
+		return x.CpuMillis
+	}
+	return 0
+}
+
+func (x *JobResources) GetMemoryMib() int64 {
+	if x != nil {
+		return x.MemoryMib
+	}
+	return 0
+}
+
+func (x *JobResources) GetMaxRunDurationSeconds() int64 {
+	if x != nil {
+		return x.MaxRunDurationSeconds
+	}
+	return 0
+}
+
+type GetJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// reveal_env_vars returns the job's env var values, decrypted if needed.
+	// Without it they are redacted, as in every other response.
+	RevealEnvVars bool `protobuf:"varint,2,opt,name=reveal_env_vars,json=revealEnvVars,proto3" json:"reveal_env_vars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetJobRequest) GetRevealEnvVars() bool {
+	if x != nil {
+		return x.RevealEnvVars
+	}
+	return false
+}
+
+type GetJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetJobHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobHistoryRequest) Reset() {
+	*x = GetJobHistoryRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobHistoryRequest) ProtoMessage() {}
+
+func (x *GetJobHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetJobHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetJobHistoryRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// JobTransition is one recorded status change of a job.
+type JobTransition struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TransitionId   string                 `protobuf:"bytes,1,opt,name=transition_id,json=transitionId,proto3" json:"transition_id,omitempty"`
+	FromStatus     string                 `protobuf:"bytes,2,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"` // Empty for the initial status.
+	ToStatus       string                 `protobuf:"bytes,3,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	TransitionedAt string                 `protobuf:"bytes,4,opt,name=transitioned_at,json=transitionedAt,proto3" json:"transitioned_at,omitempty"`
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JobTransition) Reset() {
+	*x = JobTransition{}
+	mi := &file_proto_jennah_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTransition) ProtoMessage() {}
+
+func (x *JobTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTransition.ProtoReflect.Descriptor instead.
+func (*JobTransition) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *JobTransition) GetTransitionId() string {
+	if x != nil {
+		return x.TransitionId
+	}
+	return ""
+}
+
+func (x *JobTransition) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *JobTransition) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *JobTransition) GetTransitionedAt() string {
+	if x != nil {
+		return x.TransitionedAt
+	}
+	return ""
+}
+
+func (x *JobTransition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetJobHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	CurrentStatus string                 `protobuf:"bytes,2,opt,name=current_status,json=currentStatus,proto3" json:"current_status,omitempty"`
+	Transitions   []*JobTransition       `protobuf:"bytes,3,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobHistoryResponse) Reset() {
+	*x = GetJobHistoryResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobHistoryResponse) ProtoMessage() {}
+
+func (x *GetJobHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetJobHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetJobHistoryResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetJobHistoryResponse) GetCurrentStatus() string {
+	if x != nil {
+		return x.CurrentStatus
+	}
+	return ""
+}
+
+func (x *GetJobHistoryResponse) GetTransitions() []*JobTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type WatchJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_id limits the stream to one job. Empty watches every job of the tenant.
+	// A single-job stream ends after the job reaches a terminal status.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// resume_token is the token of the last event received. Only later events are
+	// sent. Without it, a single-job stream replays the job's history first and a
+	// tenant-wide stream starts from now.
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchJobsRequest) Reset() {
+	*x = WatchJobsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJobsRequest) ProtoMessage() {}
+
+func (x *WatchJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJobsRequest.ProtoReflect.Descriptor instead.
+func (*WatchJobsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WatchJobsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *WatchJobsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type WatchJobsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	JobId      string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Transition *JobTransition         `protobuf:"bytes,2,opt,name=transition,proto3" json:"transition,omitempty"`
+	// resume_token identifies this event for reconnecting with WatchJobsRequest.
+	ResumeToken   string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchJobsResponse) Reset() {
+	*x = WatchJobsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJobsResponse) ProtoMessage() {}
+
+func (x *WatchJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJobsResponse.ProtoReflect.Descriptor instead.
+func (*WatchJobsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WatchJobsResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *WatchJobsResponse) GetTransition() *JobTransition {
+	if x != nil {
+		return x.Transition
+	}
+	return nil
+}
+
+func (x *WatchJobsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type GetCurrentTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentTenantRequest) Reset() {
+	*x = GetCurrentTenantRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentTenantRequest) ProtoMessage() {}
+
+func (x *GetCurrentTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{30}
+}
+
+type GetCurrentTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	OauthProvider string                 `protobuf:"bytes,3,opt,name=oauth_provider,json=oauthProvider,proto3" json:"oauth_provider,omitempty"` // "google", "github"
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentTenantResponse) Reset() {
+	*x = GetCurrentTenantResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentTenantResponse) ProtoMessage() {}
+
+func (x *GetCurrentTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetCurrentTenantResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetCurrentTenantResponse) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *GetCurrentTenantResponse) GetOauthProvider() string {
+	if x != nil {
+		return x.OauthProvider
+	}
+	return ""
+}
+
+func (x *GetCurrentTenantResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CancelJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type CancelJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobResponse) Reset() {
+	*x = CancelJobResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResponse) ProtoMessage() {}
+
+func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *CancelJobResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// force deletes a PENDING, SCHEDULED or RUNNING job by cancelling it first.
+	// Without it, only finished jobs can be deleted.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobRequest) ProtoMessage() {}
+
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *DeleteJobRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteJobResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	JobId   string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// previous_status is the job's status before the delete.
+	PreviousStatus string `protobuf:"bytes,3,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	// cloud_job_cancelled is true when the batch provider job was cancelled first.
+	CloudJobCancelled bool `protobuf:"varint,4,opt,name=cloud_job_cancelled,json=cloudJobCancelled,proto3" json:"cloud_job_cancelled,omitempty"`
+	// cloud_job_resource_path is the provider job the record pointed at, if any.
+	CloudJobResourcePath string `protobuf:"bytes,5,opt,name=cloud_job_resource_path,json=cloudJobResourcePath,proto3" json:"cloud_job_resource_path,omitempty"`
+	// record_deleted is true when the job row and its history were removed.
+	RecordDeleted bool `protobuf:"varint,6,opt,name=record_deleted,json=recordDeleted,proto3" json:"record_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobResponse) Reset() {
+	*x = DeleteJobResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobResponse) ProtoMessage() {}
+
+func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *DeleteJobResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteJobResponse) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *DeleteJobResponse) GetCloudJobCancelled() bool {
+	if x != nil {
+		return x.CloudJobCancelled
+	}
+	return false
+}
+
+func (x *DeleteJobResponse) GetCloudJobResourcePath() string {
+	if x != nil {
+		return x.CloudJobResourcePath
+	}
+	return ""
+}
+
+func (x *DeleteJobResponse) GetRecordDeleted() bool {
+	if x != nil {
+		return x.RecordDeleted
+	}
+	return false
+}
+
+type ListJobAttemptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobAttemptsRequest) Reset() {
+	*x = ListJobAttemptsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobAttemptsRequest) ProtoMessage() {}
+
+func (x *ListJobAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListJobAttemptsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// JobAttempt is one submission of a job to the batch provider.
+type JobAttempt struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AttemptNumber        int64                  `protobuf:"varint,1,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"` // Starts at 1.
+	Status               string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                     // A job status, or "PREEMPTED" when the provider reclaimed the attempt's VMs.
+	ProviderJobId        string                 `protobuf:"bytes,3,opt,name=provider_job_id,json=providerJobId,proto3" json:"provider_job_id,omitempty"`
+	CloudJobResourcePath string                 `protobuf:"bytes,4,opt,name=cloud_job_resource_path,json=cloudJobResourcePath,proto3" json:"cloud_job_resource_path,omitempty"`
+	FailureClass         string                 `protobuf:"bytes,5,opt,name=failure_class,json=failureClass,proto3" json:"failure_class,omitempty"` // "SUBMISSION" or "RUNTIME" for failed attempts.
+	ErrorMessage         string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt              string                 `protobuf:"bytes,8,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	ExitCode             *int32                 `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	FailureCategory      string                 `protobuf:"bytes,10,opt,name=failure_category,json=failureCategory,proto3" json:"failure_category,omitempty"`
+	ProviderEvents       []*ProviderEvent       `protobuf:"bytes,11,rep,name=provider_events,json=providerEvents,proto3" json:"provider_events,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *JobAttempt) Reset() {
+	*x = JobAttempt{}
+	mi := &file_proto_jennah_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobAttempt) ProtoMessage() {}
+
+func (x *JobAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobAttempt.ProtoReflect.Descriptor instead.
+func (*JobAttempt) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *JobAttempt) GetAttemptNumber() int64 {
+	if x != nil {
+		return x.AttemptNumber
+	}
+	return 0
+}
+
+func (x *JobAttempt) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetProviderJobId() string {
+	if x != nil {
+		return x.ProviderJobId
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetCloudJobResourcePath() string {
+	if x != nil {
+		return x.CloudJobResourcePath
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetFailureClass() string {
+	if x != nil {
+		return x.FailureClass
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *JobAttempt) GetFailureCategory() string {
+	if x != nil {
+		return x.FailureCategory
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetProviderEvents() []*ProviderEvent {
+	if x != nil {
+		return x.ProviderEvents
+	}
+	return nil
+}
+
+type ListJobAttemptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Attempts      []*JobAttempt          `protobuf:"bytes,2,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobAttemptsResponse) Reset() {
+	*x = ListJobAttemptsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobAttemptsResponse) ProtoMessage() {}
+
+func (x *ListJobAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListJobAttemptsResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ListJobAttemptsResponse) GetAttempts() []*JobAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+type GetJobLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// attempt_number selects the attempt to read. 0 reads the latest attempt.
+	AttemptNumber int64 `protobuf:"varint,2,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"`
+	// since limits results to entries at or after this RFC 3339 time (optional).
+	Since         string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 500, max 1000.
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobLogsRequest) Reset() {
+	*x = GetJobLogsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobLogsRequest) ProtoMessage() {}
+
+func (x *GetJobLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetJobLogsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetJobLogsRequest) GetAttemptNumber() int64 {
+	if x != nil {
+		return x.AttemptNumber
+	}
+	return 0
+}
+
+func (x *GetJobLogsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *GetJobLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetJobLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// LogEntry is one line of job output.
+type LogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	mi := &file_proto_jennah_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LogEntry) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
 	}
 	return ""
 }
 
-type CancelJobResponse struct {
+func (x *LogEntry) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *LogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetJobLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	AttemptNumber int64                  `protobuf:"varint,2,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"`
+	Entries       []*LogEntry            `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	// next_page_token is set when more entries are available.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelJobResponse) Reset() {
-	*x = CancelJobResponse{}
-	mi := &file_proto_jennah_proto_msgTypes[9]
+func (x *GetJobLogsResponse) Reset() {
+	*x = GetJobLogsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelJobResponse) String() string {
+func (x *GetJobLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelJobResponse) ProtoMessage() {}
+func (*GetJobLogsResponse) ProtoMessage() {}
 
-func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_jennah_proto_msgTypes[9]
+func (x *GetJobLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,47 +3213,65 @@ func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
-func (*CancelJobResponse) Descriptor() ([]byte, []int) {
-	return file_proto_jennah_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetJobLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetJobLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CancelJobResponse) GetJobId() string {
+func (x *GetJobLogsResponse) GetJobId() string {
 	if x != nil {
 		return x.JobId
 	}
 	return ""
 }
 
-func (x *CancelJobResponse) GetStatus() string {
+func (x *GetJobLogsResponse) GetAttemptNumber() int64 {
 	if x != nil {
-		return x.Status
+		return x.AttemptNumber
+	}
+	return 0
+}
+
+func (x *GetJobLogsResponse) GetEntries() []*LogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetJobLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type DeleteJobRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+type StreamJobLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// attempt_number selects the attempt to follow. 0 follows the latest attempt.
+	AttemptNumber int64 `protobuf:"varint,2,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"`
+	// since starts the stream at this RFC 3339 time. Without it the whole log is sent.
+	Since         string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteJobRequest) Reset() {
-	*x = DeleteJobRequest{}
-	mi := &file_proto_jennah_proto_msgTypes[10]
+func (x *StreamJobLogsRequest) Reset() {
+	*x = StreamJobLogsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJobRequest) String() string {
+func (x *StreamJobLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobRequest) ProtoMessage() {}
+func (*StreamJobLogsRequest) ProtoMessage() {}
 
-func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_jennah_proto_msgTypes[10]
+func (x *StreamJobLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,41 +3282,98 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return file_proto_jennah_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use StreamJobLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamJobLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DeleteJobRequest) GetJobId() string {
+func (x *StreamJobLogsRequest) GetJobId() string {
 	if x != nil {
 		return x.JobId
 	}
 	return ""
 }
 
-type DeleteJobResponse struct {
+func (x *StreamJobLogsRequest) GetAttemptNumber() int64 {
+	if x != nil {
+		return x.AttemptNumber
+	}
+	return 0
+}
+
+func (x *StreamJobLogsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+type StreamJobLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LogEntry            `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamJobLogsResponse) Reset() {
+	*x = StreamJobLogsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamJobLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamJobLogsResponse) ProtoMessage() {}
+
+func (x *StreamJobLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamJobLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamJobLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StreamJobLogsResponse) GetEntries() []*LogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ListJobTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteJobResponse) Reset() {
-	*x = DeleteJobResponse{}
-	mi := &file_proto_jennah_proto_msgTypes[11]
+func (x *ListJobTasksRequest) Reset() {
+	*x = ListJobTasksRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJobResponse) String() string {
+func (x *ListJobTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobResponse) ProtoMessage() {}
+func (*ListJobTasksRequest) ProtoMessage() {}
 
-func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_jennah_proto_msgTypes[11]
+func (x *ListJobTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,25 +3384,169 @@ func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
-func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
-	return file_proto_jennah_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListJobTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListJobTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DeleteJobResponse) GetJobId() string {
+func (x *ListJobTasksRequest) GetJobId() string {
 	if x != nil {
 		return x.JobId
 	}
 	return ""
 }
 
-func (x *DeleteJobResponse) GetMessage() string {
+// JobTask is one task of a job, as reported by the batch provider.
+type JobTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Index int64                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // BATCH_TASK_INDEX, starting at 0.
+	// status uses the job statuses. Tasks that never ran because the job ended
+	// first are CANCELLED.
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ExitCode      *int32 `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // Latest provider status event of the task.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobTask) Reset() {
+	*x = JobTask{}
+	mi := &file_proto_jennah_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTask) ProtoMessage() {}
+
+func (x *JobTask) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTask.ProtoReflect.Descriptor instead.
+func (*JobTask) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *JobTask) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *JobTask) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobTask) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *JobTask) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
+type ListJobTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// status is the job's status, which aggregates its tasks: COMPLETED once
+	// every task succeeded, FAILED once any task failed for good.
+	Status    string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	TaskCount int64  `protobuf:"varint,3,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	// task_counts is how many tasks are in each status.
+	TaskCounts map[string]int64 `protobuf:"bytes,4,rep,name=task_counts,json=taskCounts,proto3" json:"task_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// tasks is empty until the job has been submitted to the provider.
+	Tasks         []*JobTask `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobTasksResponse) Reset() {
+	*x = ListJobTasksResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobTasksResponse) ProtoMessage() {}
+
+func (x *ListJobTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListJobTasksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListJobTasksResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ListJobTasksResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListJobTasksResponse) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *ListJobTasksResponse) GetTaskCounts() map[string]int64 {
+	if x != nil {
+		return x.TaskCounts
+	}
+	return nil
+}
+
+func (x *ListJobTasksResponse) GetTasks() []*JobTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
 var File_proto_jennah_proto protoreflect.FileDescriptor
 
 const file_proto_jennah_proto_rawDesc = "" +
@@ -680,29 +3557,258 @@ const file_proto_jennah_proto_rawDesc = "" +
 	"cpu_millis\x18\x01 \x01(\x03R\tcpuMillis\x12\x1d\n" +
 	"\n" +
 	"memory_mib\x18\x02 \x01(\x03R\tmemoryMib\x127\n" +
-	"\x18max_run_duration_seconds\x18\x03 \x01(\x03R\x15maxRunDurationSeconds\"\xa5\x02\n" +
+	"\x18max_run_duration_seconds\x18\x03 \x01(\x03R\x15maxRunDurationSeconds\"\x86\n" +
+	"\n" +
 	"\x10SubmitJobRequest\x12\x1b\n" +
 	"\timage_uri\x18\x02 \x01(\tR\bimageUri\x12C\n" +
 	"\benv_vars\x18\x03 \x03(\v2(.jennah.v1.SubmitJobRequest.EnvVarsEntryR\aenvVars\x12)\n" +
 	"\x10resource_profile\x18\x04 \x01(\tR\x0fresourceProfile\x12H\n" +
-	"\x11resource_override\x18\x05 \x01(\v2\x1b.jennah.v1.ResourceOverrideR\x10resourceOverride\x1a:\n" +
+	"\x11resource_override\x18\x05 \x01(\v2\x1b.jennah.v1.ResourceOverrideR\x10resourceOverride\x129\n" +
+	"\fretry_policy\x18\x06 \x01(\v2\x16.jennah.v1.RetryPolicyR\vretryPolicy\x12I\n" +
+	"\x12lifecycle_policies\x18\a \x03(\v2\x1a.jennah.v1.LifecyclePolicyR\x11lifecyclePolicies\x12(\n" +
+	"\x10max_task_retries\x18\b \x01(\x05R\x0emaxTaskRetries\x12\x1a\n" +
+	"\bcommands\x18\t \x03(\tR\bcommands\x12\x1e\n" +
+	"\n" +
+	"entrypoint\x18\n" +
+	" \x01(\tR\n" +
+	"entrypoint\x12H\n" +
+	"\x11container_options\x18\v \x01(\v2\x1b.jennah.v1.ContainerOptionsR\x10containerOptions\x12)\n" +
+	"\x06script\x18\f \x01(\v2\x11.jennah.v1.ScriptR\x06script\x12%\n" +
+	"\x05steps\x18\r \x03(\v2\x0f.jennah.v1.StepR\x05steps\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x0e \x01(\x03R\ttaskCount\x12 \n" +
+	"\vparallelism\x18\x0f \x01(\x03R\vparallelism\x12+\n" +
+	"\avolumes\x18\x10 \x03(\v2\x11.jennah.v1.VolumeR\avolumes\x12?\n" +
+	"\x06labels\x18\x11 \x03(\v2'.jennah.v1.SubmitJobRequest.LabelsEntryR\x06labels\x12\x1a\n" +
+	"\bpriority\x18\x12 \x01(\x03R\bpriority\x125\n" +
+	"\n" +
+	"allocation\x18\x13 \x01(\v2\x15.jennah.v1.AllocationR\n" +
+	"allocation\x12H\n" +
+	"\x11preemption_policy\x18\x14 \x01(\v2\x1b.jennah.v1.PreemptionPolicyR\x10preemptionPolicy\x12V\n" +
+	"\x0fsecret_env_vars\x18\x15 \x03(\v2..jennah.v1.SubmitJobRequest.SecretEnvVarsEntryR\rsecretEnvVars\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x16 \x01(\tR\trequestId\x1a:\n" +
+	"\fEnvVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aY\n" +
+	"\x12SecretEnvVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.jennah.v1.SecretEnvVarR\x05value:\x028\x01\"@\n" +
+	"\fSecretEnvVar\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"e\n" +
+	"\x10PreemptionPolicy\x12\x1f\n" +
+	"\vmax_retries\x18\x01 \x01(\x03R\n" +
+	"maxRetries\x120\n" +
+	"\x14fallback_to_standard\x18\x02 \x01(\bR\x12fallbackToStandard\"t\n" +
+	"\n" +
+	"Allocation\x12!\n" +
+	"\fmachine_type\x18\x01 \x01(\tR\vmachineType\x12-\n" +
+	"\x12provisioning_model\x18\x02 \x01(\tR\x11provisioningModel\x12\x14\n" +
+	"\x05zones\x18\x03 \x03(\tR\x05zones\"\xbf\x01\n" +
+	"\x06Volume\x12\x1d\n" +
+	"\n" +
+	"mount_path\x18\x01 \x01(\tR\tmountPath\x12\x1b\n" +
+	"\tread_only\x18\x02 \x01(\bR\breadOnly\x12&\n" +
+	"\x03gcs\x18\x03 \x01(\v2\x14.jennah.v1.GcsVolumeR\x03gcs\x12&\n" +
+	"\x03nfs\x18\x04 \x01(\v2\x14.jennah.v1.NfsVolumeR\x03nfs\x12)\n" +
+	"\x04disk\x18\x05 \x01(\v2\x15.jennah.v1.DiskVolumeR\x04disk\"7\n" +
+	"\tGcsVolume\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"D\n" +
+	"\tNfsVolume\x12\x16\n" +
+	"\x06server\x18\x01 \x01(\tR\x06server\x12\x1f\n" +
+	"\vremote_path\x18\x02 \x01(\tR\n" +
+	"remotePath\"9\n" +
+	"\n" +
+	"DiskVolume\x12\x17\n" +
+	"\asize_gb\x18\x01 \x01(\x03R\x06sizeGb\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"\xc5\x03\n" +
+	"\x04Step\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_uri\x18\x02 \x01(\tR\bimageUri\x12\x1a\n" +
+	"\bcommands\x18\x03 \x03(\tR\bcommands\x12\x1e\n" +
+	"\n" +
+	"entrypoint\x18\x04 \x01(\tR\n" +
+	"entrypoint\x12H\n" +
+	"\x11container_options\x18\x05 \x01(\v2\x1b.jennah.v1.ContainerOptionsR\x10containerOptions\x12)\n" +
+	"\x06script\x18\x06 \x01(\v2\x11.jennah.v1.ScriptR\x06script\x12\x18\n" +
+	"\abarrier\x18\a \x01(\bR\abarrier\x127\n" +
+	"\benv_vars\x18\b \x03(\v2\x1c.jennah.v1.Step.EnvVarsEntryR\aenvVars\x12\x1e\n" +
+	"\n" +
+	"background\x18\t \x01(\bR\n" +
+	"background\x12,\n" +
+	"\x12ignore_exit_status\x18\n" +
+	" \x01(\bR\x10ignoreExitStatus\x1a:\n" +
 	"\fEnvVarsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"k\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x06Script\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12 \n" +
+	"\vinterpreter\x18\x02 \x01(\tR\vinterpreter\"G\n" +
+	"\x10ContainerOptions\x12\x1f\n" +
+	"\vworking_dir\x18\x01 \x01(\tR\n" +
+	"workingDir\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\"H\n" +
+	"\x0fLifecyclePolicy\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"exit_codes\x18\x02 \x03(\x05R\texitCodes\"\xe2\x01\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x03R\vmaxAttempts\x126\n" +
+	"\x17initial_backoff_seconds\x18\x02 \x01(\x03R\x15initialBackoffSeconds\x12.\n" +
+	"\x13max_backoff_seconds\x18\x03 \x01(\x03R\x11maxBackoffSeconds\x12-\n" +
+	"\x12backoff_multiplier\x18\x04 \x01(\x01R\x11backoffMultiplier\x12\x19\n" +
+	"\bretry_on\x18\x05 \x03(\tR\aretryOn\"k\n" +
 	"\x11SubmitJobResponse\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
-	"\x0fworker_assigned\x18\x03 \x01(\tR\x0eworkerAssigned\"\x11\n" +
-	"\x0fListJobsRequest\"6\n" +
+	"\x0fworker_assigned\x18\x03 \x01(\tR\x0eworkerAssigned\"\x98\x03\n" +
+	"\x0fRerunJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12B\n" +
+	"\benv_vars\x18\x02 \x03(\v2'.jennah.v1.RerunJobRequest.EnvVarsEntryR\aenvVars\x12)\n" +
+	"\x10resource_profile\x18\x03 \x01(\tR\x0fresourceProfile\x12H\n" +
+	"\x11resource_override\x18\x04 \x01(\v2\x1b.jennah.v1.ResourceOverrideR\x10resourceOverride\x12>\n" +
+	"\x06labels\x18\x05 \x03(\v2&.jennah.v1.RerunJobRequest.LabelsEntryR\x06labels\x1a:\n" +
+	"\fEnvVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x97\x01\n" +
+	"\x10RerunJobResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0fworker_assigned\x18\x03 \x01(\tR\x0eworkerAssigned\x12+\n" +
+	"\x12cloned_from_job_id\x18\x04 \x01(\tR\x0fclonedFromJobId\"\x88\x02\n" +
+	"\x0fListJobsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bstatuses\x18\x03 \x03(\tR\bstatuses\x12#\n" +
+	"\rcreated_after\x18\x04 \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x05 \x01(\tR\rcreatedBefore\x12(\n" +
+	"\x10image_uri_prefix\x18\x06 \x01(\tR\x0eimageUriPrefix\x12'\n" +
+	"\x0flabel_selectors\x18\a \x03(\tR\x0elabelSelectors\"^\n" +
 	"\x10ListJobsResponse\x12\"\n" +
-	"\x04jobs\x18\x01 \x03(\v2\x0e.jennah.v1.JobR\x04jobs\"\x8d\x01\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x0e.jennah.v1.JobR\x04jobs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe2\a\n" +
 	"\x03Job\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1b\n" +
 	"\timage_uri\x18\x03 \x01(\tR\bimageUri\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x19\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12!\n" +
+	"\fscheduled_at\x18\a \x01(\tR\vscheduledAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\t \x01(\tR\vcompletedAt\x12\x1f\n" +
+	"\vretry_count\x18\n" +
+	" \x01(\x03R\n" +
+	"retryCount\x12\x1f\n" +
+	"\vmax_retries\x18\v \x01(\x03R\n" +
+	"maxRetries\x12#\n" +
+	"\rerror_message\x18\f \x01(\tR\ferrorMessage\x125\n" +
+	"\x17cloud_job_resource_path\x18\r \x01(\tR\x14cloudJobResourcePath\x12\x1a\n" +
+	"\bcommands\x18\x0e \x03(\tR\bcommands\x12)\n" +
+	"\x10resource_profile\x18\x0f \x01(\tR\x0fresourceProfile\x125\n" +
+	"\tresources\x18\x10 \x01(\v2\x17.jennah.v1.JobResourcesR\tresources\x12&\n" +
+	"\x04spec\x18\x11 \x01(\v2\x12.jennah.v1.JobSpecR\x04spec\x12+\n" +
+	"\x12cloned_from_job_id\x18\x12 \x01(\tR\x0fclonedFromJobId\x12&\n" +
+	"\x0fnext_attempt_at\x18\x13 \x01(\tR\rnextAttemptAt\x12 \n" +
+	"\texit_code\x18\x14 \x01(\x05H\x00R\bexitCode\x88\x01\x01\x12)\n" +
+	"\x10failure_category\x18\x15 \x01(\tR\x0ffailureCategory\x12A\n" +
+	"\x0fprovider_events\x18\x16 \x03(\v2\x18.jennah.v1.ProviderEventR\x0eproviderEvents\x122\n" +
+	"\x06labels\x18\x17 \x03(\v2\x1a.jennah.v1.Job.LabelsEntryR\x06labels\x12)\n" +
+	"\x10preemption_count\x18\x18 \x01(\x03R\x0fpreemptionCount\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_exit_code\"d\n" +
+	"\rProviderEvent\x12\x1d\n" +
+	"\n" +
+	"event_time\x18\x01 \x01(\tR\teventTime\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x94\n" +
+	"\n" +
+	"\aJobSpec\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1b\n" +
+	"\timage_uri\x18\x02 \x01(\tR\bimageUri\x12\x1a\n" +
+	"\bcommands\x18\x03 \x03(\tR\bcommands\x12:\n" +
+	"\benv_vars\x18\x04 \x03(\v2\x1f.jennah.v1.JobSpec.EnvVarsEntryR\aenvVars\x12)\n" +
+	"\x10resource_profile\x18\x05 \x01(\tR\x0fresourceProfile\x12H\n" +
+	"\x11resource_override\x18\x06 \x01(\v2\x1b.jennah.v1.ResourceOverrideR\x10resourceOverride\x125\n" +
+	"\tresources\x18\a \x01(\v2\x17.jennah.v1.JobResourcesR\tresources\x129\n" +
+	"\fretry_policy\x18\b \x01(\v2\x16.jennah.v1.RetryPolicyR\vretryPolicy\x12I\n" +
+	"\x12lifecycle_policies\x18\t \x03(\v2\x1a.jennah.v1.LifecyclePolicyR\x11lifecyclePolicies\x12(\n" +
+	"\x10max_task_retries\x18\n" +
+	" \x01(\x05R\x0emaxTaskRetries\x12\x1e\n" +
+	"\n" +
+	"entrypoint\x18\v \x01(\tR\n" +
+	"entrypoint\x12H\n" +
+	"\x11container_options\x18\f \x01(\v2\x1b.jennah.v1.ContainerOptionsR\x10containerOptions\x12)\n" +
+	"\x06script\x18\r \x01(\v2\x11.jennah.v1.ScriptR\x06script\x12%\n" +
+	"\x05steps\x18\x0e \x03(\v2\x0f.jennah.v1.StepR\x05steps\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x0f \x01(\x03R\ttaskCount\x12 \n" +
+	"\vparallelism\x18\x10 \x01(\x03R\vparallelism\x12+\n" +
+	"\avolumes\x18\x11 \x03(\v2\x11.jennah.v1.VolumeR\avolumes\x126\n" +
+	"\x06labels\x18\x12 \x03(\v2\x1e.jennah.v1.JobSpec.LabelsEntryR\x06labels\x12\x1a\n" +
+	"\bpriority\x18\x13 \x01(\x03R\bpriority\x125\n" +
+	"\n" +
+	"allocation\x18\x14 \x01(\v2\x15.jennah.v1.AllocationR\n" +
+	"allocation\x12H\n" +
+	"\x11preemption_policy\x18\x15 \x01(\v2\x1b.jennah.v1.PreemptionPolicyR\x10preemptionPolicy\x12M\n" +
+	"\x0fsecret_env_vars\x18\x16 \x03(\v2%.jennah.v1.JobSpec.SecretEnvVarsEntryR\rsecretEnvVars\x1a:\n" +
+	"\fEnvVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aY\n" +
+	"\x12SecretEnvVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.jennah.v1.SecretEnvVarR\x05value:\x028\x01\"\x85\x01\n" +
+	"\fJobResources\x12\x1d\n" +
+	"\n" +
+	"cpu_millis\x18\x01 \x01(\x03R\tcpuMillis\x12\x1d\n" +
+	"\n" +
+	"memory_mib\x18\x02 \x01(\x03R\tmemoryMib\x127\n" +
+	"\x18max_run_duration_seconds\x18\x03 \x01(\x03R\x15maxRunDurationSeconds\"N\n" +
+	"\rGetJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12&\n" +
+	"\x0freveal_env_vars\x18\x02 \x01(\bR\rrevealEnvVars\"2\n" +
+	"\x0eGetJobResponse\x12 \n" +
+	"\x03job\x18\x01 \x01(\v2\x0e.jennah.v1.JobR\x03job\"-\n" +
+	"\x14GetJobHistoryRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xb3\x01\n" +
+	"\rJobTransition\x12#\n" +
+	"\rtransition_id\x18\x01 \x01(\tR\ftransitionId\x12\x1f\n" +
+	"\vfrom_status\x18\x02 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x03 \x01(\tR\btoStatus\x12'\n" +
+	"\x0ftransitioned_at\x18\x04 \x01(\tR\x0etransitionedAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x91\x01\n" +
+	"\x15GetJobHistoryResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12%\n" +
+	"\x0ecurrent_status\x18\x02 \x01(\tR\rcurrentStatus\x12:\n" +
+	"\vtransitions\x18\x03 \x03(\v2\x18.jennah.v1.JobTransitionR\vtransitions\"L\n" +
+	"\x10WatchJobsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\x87\x01\n" +
+	"\x11WatchJobsResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x128\n" +
+	"\n" +
+	"transition\x18\x02 \x01(\v2\x18.jennah.v1.JobTransitionR\n" +
+	"transition\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"\x19\n" +
 	"\x17GetCurrentTenantRequest\"\x9c\x01\n" +
 	"\x18GetCurrentTenantResponse\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
@@ -715,18 +3821,96 @@ const file_proto_jennah_proto_rawDesc = "" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"B\n" +
 	"\x11CancelJobResponse\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\")\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"?\n" +
 	"\x10DeleteJobRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"D\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\xfb\x01\n" +
 	"\x11DeleteJobResponse\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x8d\x03\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x0fprevious_status\x18\x03 \x01(\tR\x0epreviousStatus\x12.\n" +
+	"\x13cloud_job_cancelled\x18\x04 \x01(\bR\x11cloudJobCancelled\x125\n" +
+	"\x17cloud_job_resource_path\x18\x05 \x01(\tR\x14cloudJobResourcePath\x12%\n" +
+	"\x0erecord_deleted\x18\x06 \x01(\bR\rrecordDeleted\"/\n" +
+	"\x16ListJobAttemptsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xcc\x03\n" +
+	"\n" +
+	"JobAttempt\x12%\n" +
+	"\x0eattempt_number\x18\x01 \x01(\x03R\rattemptNumber\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12&\n" +
+	"\x0fprovider_job_id\x18\x03 \x01(\tR\rproviderJobId\x125\n" +
+	"\x17cloud_job_resource_path\x18\x04 \x01(\tR\x14cloudJobResourcePath\x12#\n" +
+	"\rfailure_class\x18\x05 \x01(\tR\ffailureClass\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\tR\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\b \x01(\tR\aendedAt\x12 \n" +
+	"\texit_code\x18\t \x01(\x05H\x00R\bexitCode\x88\x01\x01\x12)\n" +
+	"\x10failure_category\x18\n" +
+	" \x01(\tR\x0ffailureCategory\x12A\n" +
+	"\x0fprovider_events\x18\v \x03(\v2\x18.jennah.v1.ProviderEventR\x0eproviderEventsB\f\n" +
+	"\n" +
+	"_exit_code\"c\n" +
+	"\x17ListJobAttemptsResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x121\n" +
+	"\battempts\x18\x02 \x03(\v2\x15.jennah.v1.JobAttemptR\battempts\"\xa3\x01\n" +
+	"\x11GetJobLogsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12%\n" +
+	"\x0eattempt_number\x18\x02 \x01(\x03R\rattemptNumber\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\tR\x05since\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"^\n" +
+	"\bLogEntry\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa9\x01\n" +
+	"\x12GetJobLogsResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12%\n" +
+	"\x0eattempt_number\x18\x02 \x01(\x03R\rattemptNumber\x12-\n" +
+	"\aentries\x18\x03 \x03(\v2\x13.jennah.v1.LogEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"j\n" +
+	"\x14StreamJobLogsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12%\n" +
+	"\x0eattempt_number\x18\x02 \x01(\x03R\rattemptNumber\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\tR\x05since\"F\n" +
+	"\x15StreamJobLogsResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.jennah.v1.LogEntryR\aentries\",\n" +
+	"\x13ListJobTasksRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x81\x01\n" +
+	"\aJobTask\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x03R\x05index\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12 \n" +
+	"\texit_code\x18\x03 \x01(\x05H\x00R\bexitCode\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessageB\f\n" +
+	"\n" +
+	"_exit_code\"\x9f\x02\n" +
+	"\x14ListJobTasksResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x03 \x01(\x03R\ttaskCount\x12P\n" +
+	"\vtask_counts\x18\x04 \x03(\v2/.jennah.v1.ListJobTasksResponse.TaskCountsEntryR\n" +
+	"taskCounts\x12(\n" +
+	"\x05tasks\x18\x05 \x03(\v2\x12.jennah.v1.JobTaskR\x05tasks\x1a=\n" +
+	"\x0fTaskCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x012\xfb\a\n" +
 	"\x11DeploymentService\x12F\n" +
 	"\tSubmitJob\x12\x1b.jennah.v1.SubmitJobRequest\x1a\x1c.jennah.v1.SubmitJobResponse\x12C\n" +
-	"\bListJobs\x12\x1a.jennah.v1.ListJobsRequest\x1a\x1b.jennah.v1.ListJobsResponse\x12[\n" +
+	"\bListJobs\x12\x1a.jennah.v1.ListJobsRequest\x1a\x1b.jennah.v1.ListJobsResponse\x12C\n" +
+	"\bRerunJob\x12\x1a.jennah.v1.RerunJobRequest\x1a\x1b.jennah.v1.RerunJobResponse\x12=\n" +
+	"\x06GetJob\x12\x18.jennah.v1.GetJobRequest\x1a\x19.jennah.v1.GetJobResponse\x12R\n" +
+	"\rGetJobHistory\x12\x1f.jennah.v1.GetJobHistoryRequest\x1a .jennah.v1.GetJobHistoryResponse\x12H\n" +
+	"\tWatchJobs\x12\x1b.jennah.v1.WatchJobsRequest\x1a\x1c.jennah.v1.WatchJobsResponse0\x01\x12[\n" +
 	"\x10GetCurrentTenant\x12\".jennah.v1.GetCurrentTenantRequest\x1a#.jennah.v1.GetCurrentTenantResponse\x12F\n" +
 	"\tCancelJob\x12\x1b.jennah.v1.CancelJobRequest\x1a\x1c.jennah.v1.CancelJobResponse\x12F\n" +
-	"\tDeleteJob\x12\x1b.jennah.v1.DeleteJobRequest\x1a\x1c.jennah.v1.DeleteJobResponseB2Z0github.com/alphauslabs/jennah/gen/proto;jennahv1b\x06proto3"
+	"\tDeleteJob\x12\x1b.jennah.v1.DeleteJobRequest\x1a\x1c.jennah.v1.DeleteJobResponse\x12X\n" +
+	"\x0fListJobAttempts\x12!.jennah.v1.ListJobAttemptsRequest\x1a\".jennah.v1.ListJobAttemptsResponse\x12I\n" +
+	"\n" +
+	"GetJobLogs\x12\x1c.jennah.v1.GetJobLogsRequest\x1a\x1d.jennah.v1.GetJobLogsResponse\x12T\n" +
+	"\rStreamJobLogs\x12\x1f.jennah.v1.StreamJobLogsRequest\x1a .jennah.v1.StreamJobLogsResponse0\x01\x12O\n" +
+	"\fListJobTasks\x12\x1e.jennah.v1.ListJobTasksRequest\x1a\x1f.jennah.v1.ListJobTasksResponseB2Z0github.com/alphauslabs/jennah/gen/proto;jennahv1b\x06proto3"
 
 var (
 	file_proto_jennah_proto_rawDescOnce sync.Once
@@ -740,41 +3924,149 @@ func file_proto_jennah_proto_rawDescGZIP() []byte {
 	return file_proto_jennah_proto_rawDescData
 }
 
-var file_proto_jennah_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_jennah_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_proto_jennah_proto_goTypes = []any{
 	(*ResourceOverride)(nil),         // 0: jennah.v1.ResourceOverride
 	(*SubmitJobRequest)(nil),         // 1: jennah.v1.SubmitJobRequest
-	(*SubmitJobResponse)(nil),        // 2: jennah.v1.SubmitJobResponse
-	(*ListJobsRequest)(nil),          // 3: jennah.v1.ListJobsRequest
-	(*ListJobsResponse)(nil),         // 4: jennah.v1.ListJobsResponse
-	(*Job)(nil),                      // 5: jennah.v1.Job
-	(*GetCurrentTenantRequest)(nil),  // 6: jennah.v1.GetCurrentTenantRequest
-	(*GetCurrentTenantResponse)(nil), // 7: jennah.v1.GetCurrentTenantResponse
-	(*CancelJobRequest)(nil),         // 8: jennah.v1.CancelJobRequest
-	(*CancelJobResponse)(nil),        // 9: jennah.v1.CancelJobResponse
-	(*DeleteJobRequest)(nil),         // 10: jennah.v1.DeleteJobRequest
-	(*DeleteJobResponse)(nil),        // 11: jennah.v1.DeleteJobResponse
-	nil,                              // 12: jennah.v1.SubmitJobRequest.EnvVarsEntry
+	(*SecretEnvVar)(nil),             // 2: jennah.v1.SecretEnvVar
+	(*PreemptionPolicy)(nil),         // 3: jennah.v1.PreemptionPolicy
+	(*Allocation)(nil),               // 4: jennah.v1.Allocation
+	(*Volume)(nil),                   // 5: jennah.v1.Volume
+	(*GcsVolume)(nil),                // 6: jennah.v1.GcsVolume
+	(*NfsVolume)(nil),                // 7: jennah.v1.NfsVolume
+	(*DiskVolume)(nil),               // 8: jennah.v1.DiskVolume
+	(*Step)(nil),                     // 9: jennah.v1.Step
+	(*Script)(nil),                   // 10: jennah.v1.Script
+	(*ContainerOptions)(nil),         // 11: jennah.v1.ContainerOptions
+	(*LifecyclePolicy)(nil),          // 12: jennah.v1.LifecyclePolicy
+	(*RetryPolicy)(nil),              // 13: jennah.v1.RetryPolicy
+	(*SubmitJobResponse)(nil),        // 14: jennah.v1.SubmitJobResponse
+	(*RerunJobRequest)(nil),          // 15: jennah.v1.RerunJobRequest
+	(*RerunJobResponse)(nil),         // 16: jennah.v1.RerunJobResponse
+	(*ListJobsRequest)(nil),          // 17: jennah.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),         // 18: jennah.v1.ListJobsResponse
+	(*Job)(nil),                      // 19: jennah.v1.Job
+	(*ProviderEvent)(nil),            // 20: jennah.v1.ProviderEvent
+	(*JobSpec)(nil),                  // 21: jennah.v1.JobSpec
+	(*JobResources)(nil),             // 22: jennah.v1.JobResources
+	(*GetJobRequest)(nil),            // 23: jennah.v1.GetJobRequest
+	(*GetJobResponse)(nil),           // 24: jennah.v1.GetJobResponse
+	(*GetJobHistoryRequest)(nil),     // 25: jennah.v1.GetJobHistoryRequest
+	(*JobTransition)(nil),            // 26: jennah.v1.JobTransition
+	(*GetJobHistoryResponse)(nil),    // 27: jennah.v1.GetJobHistoryResponse
+	(*WatchJobsRequest)(nil),         // 28: jennah.v1.WatchJobsRequest
+	(*WatchJobsResponse)(nil),        // 29: jennah.v1.WatchJobsResponse
+	(*GetCurrentTenantRequest)(nil),  // 30: jennah.v1.GetCurrentTenantRequest
+	(*GetCurrentTenantResponse)(nil), // 31: jennah.v1.GetCurrentTenantResponse
+	(*CancelJobRequest)(nil),         // 32: jennah.v1.CancelJobRequest
+	(*CancelJobResponse)(nil),        // 33: jennah.v1.CancelJobResponse
+	(*DeleteJobRequest)(nil),         // 34: jennah.v1.DeleteJobRequest
+	(*DeleteJobResponse)(nil),        // 35: jennah.v1.DeleteJobResponse
+	(*ListJobAttemptsRequest)(nil),   // 36: jennah.v1.ListJobAttemptsRequest
+	(*JobAttempt)(nil),               // 37: jennah.v1.JobAttempt
+	(*ListJobAttemptsResponse)(nil),  // 38: jennah.v1.ListJobAttemptsResponse
+	(*GetJobLogsRequest)(nil),        // 39: jennah.v1.GetJobLogsRequest
+	(*LogEntry)(nil),                 // 40: jennah.v1.LogEntry
+	(*GetJobLogsResponse)(nil),       // 41: jennah.v1.GetJobLogsResponse
+	(*StreamJobLogsRequest)(nil),     // 42: jennah.v1.StreamJobLogsRequest
+	(*StreamJobLogsResponse)(nil),    // 43: jennah.v1.StreamJobLogsResponse
+	(*ListJobTasksRequest)(nil),      // 44: jennah.v1.ListJobTasksRequest
+	(*JobTask)(nil),                  // 45: jennah.v1.JobTask
+	(*ListJobTasksResponse)(nil),     // 46: jennah.v1.ListJobTasksResponse
+	nil,                              // 47: jennah.v1.SubmitJobRequest.EnvVarsEntry
+	nil,                              // 48: jennah.v1.SubmitJobRequest.LabelsEntry
+	nil,                              // 49: jennah.v1.SubmitJobRequest.SecretEnvVarsEntry
+	nil,                              // 50: jennah.v1.Step.EnvVarsEntry
+	nil,                              // 51: jennah.v1.RerunJobRequest.EnvVarsEntry
+	nil,                              // 52: jennah.v1.RerunJobRequest.LabelsEntry
+	nil,                              // 53: jennah.v1.Job.LabelsEntry
+	nil,                              // 54: jennah.v1.JobSpec.EnvVarsEntry
+	nil,                              // 55: jennah.v1.JobSpec.LabelsEntry
+	nil,                              // 56: jennah.v1.JobSpec.SecretEnvVarsEntry
+	nil,                              // 57: jennah.v1.ListJobTasksResponse.TaskCountsEntry
 }
 var file_proto_jennah_proto_depIdxs = []int32{
-	12, // 0: jennah.v1.SubmitJobRequest.env_vars:type_name -> jennah.v1.SubmitJobRequest.EnvVarsEntry
+	47, // 0: jennah.v1.SubmitJobRequest.env_vars:type_name -> jennah.v1.SubmitJobRequest.EnvVarsEntry
 	0,  // 1: jennah.v1.SubmitJobRequest.resource_override:type_name -> jennah.v1.ResourceOverride
-	5,  // 2: jennah.v1.ListJobsResponse.jobs:type_name -> jennah.v1.Job
-	1,  // 3: jennah.v1.DeploymentService.SubmitJob:input_type -> jennah.v1.SubmitJobRequest
-	3,  // 4: jennah.v1.DeploymentService.ListJobs:input_type -> jennah.v1.ListJobsRequest
-	6,  // 5: jennah.v1.DeploymentService.GetCurrentTenant:input_type -> jennah.v1.GetCurrentTenantRequest
-	8,  // 6: jennah.v1.DeploymentService.CancelJob:input_type -> jennah.v1.CancelJobRequest
-	10, // 7: jennah.v1.DeploymentService.DeleteJob:input_type -> jennah.v1.DeleteJobRequest
-	2,  // 8: jennah.v1.DeploymentService.SubmitJob:output_type -> jennah.v1.SubmitJobResponse
-	4,  // 9: jennah.v1.DeploymentService.ListJobs:output_type -> jennah.v1.ListJobsResponse
-	7,  // 10: jennah.v1.DeploymentService.GetCurrentTenant:output_type -> jennah.v1.GetCurrentTenantResponse
-	9,  // 11: jennah.v1.DeploymentService.CancelJob:output_type -> jennah.v1.CancelJobResponse
-	11, // 12: jennah.v1.DeploymentService.DeleteJob:output_type -> jennah.v1.DeleteJobResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	13, // 2: jennah.v1.SubmitJobRequest.retry_policy:type_name -> jennah.v1.RetryPolicy
+	12, // 3: jennah.v1.SubmitJobRequest.lifecycle_policies:type_name -> jennah.v1.LifecyclePolicy
+	11, // 4: jennah.v1.SubmitJobRequest.container_options:type_name -> jennah.v1.ContainerOptions
+	10, // 5: jennah.v1.SubmitJobRequest.script:type_name -> jennah.v1.Script
+	9,  // 6: jennah.v1.SubmitJobRequest.steps:type_name -> jennah.v1.Step
+	5,  // 7: jennah.v1.SubmitJobRequest.volumes:type_name -> jennah.v1.Volume
+	48, // 8: jennah.v1.SubmitJobRequest.labels:type_name -> jennah.v1.SubmitJobRequest.LabelsEntry
+	4,  // 9: jennah.v1.SubmitJobRequest.allocation:type_name -> jennah.v1.Allocation
+	3,  // 10: jennah.v1.SubmitJobRequest.preemption_policy:type_name -> jennah.v1.PreemptionPolicy
+	49, // 11: jennah.v1.SubmitJobRequest.secret_env_vars:type_name -> jennah.v1.SubmitJobRequest.SecretEnvVarsEntry
+	6,  // 12: jennah.v1.Volume.gcs:type_name -> jennah.v1.GcsVolume
+	7,  // 13: jennah.v1.Volume.nfs:type_name -> jennah.v1.NfsVolume
+	8,  // 14: jennah.v1.Volume.disk:type_name -> jennah.v1.DiskVolume
+	11, // 15: jennah.v1.Step.container_options:type_name -> jennah.v1.ContainerOptions
+	10, // 16: jennah.v1.Step.script:type_name -> jennah.v1.Script
+	50, // 17: jennah.v1.Step.env_vars:type_name -> jennah.v1.Step.EnvVarsEntry
+	51, // 18: jennah.v1.RerunJobRequest.env_vars:type_name -> jennah.v1.RerunJobRequest.EnvVarsEntry
+	0,  // 19: jennah.v1.RerunJobRequest.resource_override:type_name -> jennah.v1.ResourceOverride
+	52, // 20: jennah.v1.RerunJobRequest.labels:type_name -> jennah.v1.RerunJobRequest.LabelsEntry
+	19, // 21: jennah.v1.ListJobsResponse.jobs:type_name -> jennah.v1.Job
+	22, // 22: jennah.v1.Job.resources:type_name -> jennah.v1.JobResources
+	21, // 23: jennah.v1.Job.spec:type_name -> jennah.v1.JobSpec
+	20, // 24: jennah.v1.Job.provider_events:type_name -> jennah.v1.ProviderEvent
+	53, // 25: jennah.v1.Job.labels:type_name -> jennah.v1.Job.LabelsEntry
+	54, // 26: jennah.v1.JobSpec.env_vars:type_name -> jennah.v1.JobSpec.EnvVarsEntry
+	0,  // 27: jennah.v1.JobSpec.resource_override:type_name -> jennah.v1.ResourceOverride
+	22, // 28: jennah.v1.JobSpec.resources:type_name -> jennah.v1.JobResources
+	13, // 29: jennah.v1.JobSpec.retry_policy:type_name -> jennah.v1.RetryPolicy
+	12, // 30: jennah.v1.JobSpec.lifecycle_policies:type_name -> jennah.v1.LifecyclePolicy
+	11, // 31: jennah.v1.JobSpec.container_options:type_name -> jennah.v1.ContainerOptions
+	10, // 32: jennah.v1.JobSpec.script:type_name -> jennah.v1.Script
+	9,  // 33: jennah.v1.JobSpec.steps:type_name -> jennah.v1.Step
+	5,  // 34: jennah.v1.JobSpec.volumes:type_name -> jennah.v1.Volume
+	55, // 35: jennah.v1.JobSpec.labels:type_name -> jennah.v1.JobSpec.LabelsEntry
+	4,  // 36: jennah.v1.JobSpec.allocation:type_name -> jennah.v1.Allocation
+	3,  // 37: jennah.v1.JobSpec.preemption_policy:type_name -> jennah.v1.PreemptionPolicy
+	56, // 38: jennah.v1.JobSpec.secret_env_vars:type_name -> jennah.v1.JobSpec.SecretEnvVarsEntry
+	19, // 39: jennah.v1.GetJobResponse.job:type_name -> jennah.v1.Job
+	26, // 40: jennah.v1.GetJobHistoryResponse.transitions:type_name -> jennah.v1.JobTransition
+	26, // 41: jennah.v1.WatchJobsResponse.transition:type_name -> jennah.v1.JobTransition
+	20, // 42: jennah.v1.JobAttempt.provider_events:type_name -> jennah.v1.ProviderEvent
+	37, // 43: jennah.v1.ListJobAttemptsResponse.attempts:type_name -> jennah.v1.JobAttempt
+	40, // 44: jennah.v1.GetJobLogsResponse.entries:type_name -> jennah.v1.LogEntry
+	40, // 45: jennah.v1.StreamJobLogsResponse.entries:type_name -> jennah.v1.LogEntry
+	57, // 46: jennah.v1.ListJobTasksResponse.task_counts:type_name -> jennah.v1.ListJobTasksResponse.TaskCountsEntry
+	45, // 47: jennah.v1.ListJobTasksResponse.tasks:type_name -> jennah.v1.JobTask
+	2,  // 48: jennah.v1.SubmitJobRequest.SecretEnvVarsEntry.value:type_name -> jennah.v1.SecretEnvVar
+	2,  // 49: jennah.v1.JobSpec.SecretEnvVarsEntry.value:type_name -> jennah.v1.SecretEnvVar
+	1,  // 50: jennah.v1.DeploymentService.SubmitJob:input_type -> jennah.v1.SubmitJobRequest
+	17, // 51: jennah.v1.DeploymentService.ListJobs:input_type -> jennah.v1.ListJobsRequest
+	15, // 52: jennah.v1.DeploymentService.RerunJob:input_type -> jennah.v1.RerunJobRequest
+	23, // 53: jennah.v1.DeploymentService.GetJob:input_type -> jennah.v1.GetJobRequest
+	25, // 54: jennah.v1.DeploymentService.GetJobHistory:input_type -> jennah.v1.GetJobHistoryRequest
+	28, // 55: jennah.v1.DeploymentService.WatchJobs:input_type -> jennah.v1.WatchJobsRequest
+	30, // 56: jennah.v1.DeploymentService.GetCurrentTenant:input_type -> jennah.v1.GetCurrentTenantRequest
+	32, // 57: jennah.v1.DeploymentService.CancelJob:input_type -> jennah.v1.CancelJobRequest
+	34, // 58: jennah.v1.DeploymentService.DeleteJob:input_type -> jennah.v1.DeleteJobRequest
+	36, // 59: jennah.v1.DeploymentService.ListJobAttempts:input_type -> jennah.v1.ListJobAttemptsRequest
+	39, // 60: jennah.v1.DeploymentService.GetJobLogs:input_type -> jennah.v1.GetJobLogsRequest
+	42, // 61: jennah.v1.DeploymentService.StreamJobLogs:input_type -> jennah.v1.StreamJobLogsRequest
+	44, // 62: jennah.v1.DeploymentService.ListJobTasks:input_type -> jennah.v1.ListJobTasksRequest
+	14, // 63: jennah.v1.DeploymentService.SubmitJob:output_type -> jennah.v1.SubmitJobResponse
+	18, // 64: jennah.v1.DeploymentService.ListJobs:output_type -> jennah.v1.ListJobsResponse
+	16, // 65: jennah.v1.DeploymentService.RerunJob:output_type -> jennah.v1.RerunJobResponse
+	24, // 66: jennah.v1.DeploymentService.GetJob:output_type -> jennah.v1.GetJobResponse
+	27, // 67: jennah.v1.DeploymentService.GetJobHistory:output_type -> jennah.v1.GetJobHistoryResponse
+	29, // 68: jennah.v1.DeploymentService.WatchJobs:output_type -> jennah.v1.WatchJobsResponse
+	31, // 69: jennah.v1.DeploymentService.GetCurrentTenant:output_type -> jennah.v1.GetCurrentTenantResponse
+	33, // 70: jennah.v1.DeploymentService.CancelJob:output_type -> jennah.v1.CancelJobResponse
+	35, // 71: jennah.v1.DeploymentService.DeleteJob:output_type -> jennah.v1.DeleteJobResponse
+	38, // 72: jennah.v1.DeploymentService.ListJobAttempts:output_type -> jennah.v1.ListJobAttemptsResponse
+	41, // 73: jennah.v1.DeploymentService.GetJobLogs:output_type -> jennah.v1.GetJobLogsResponse
+	43, // 74: jennah.v1.DeploymentService.StreamJobLogs:output_type -> jennah.v1.StreamJobLogsResponse
+	46, // 75: jennah.v1.DeploymentService.ListJobTasks:output_type -> jennah.v1.ListJobTasksResponse
+	63, // [63:76] is the sub-list for method output_type
+	50, // [50:63] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_proto_jennah_proto_init() }
@@ -782,13 +4074,16 @@ func file_proto_jennah_proto_init() {
 	if File_proto_jennah_proto != nil {
 		return
 	}
+	file_proto_jennah_proto_msgTypes[19].OneofWrappers = []any{}
+	file_proto_jennah_proto_msgTypes[37].OneofWrappers = []any{}
+	file_proto_jennah_proto_msgTypes[45].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_jennah_proto_rawDesc), len(file_proto_jennah_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
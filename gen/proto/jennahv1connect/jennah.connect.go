@@ -39,6 +39,18 @@ const (
 	// DeploymentServiceListJobsProcedure is the fully-qualified name of the DeploymentService's
 	// ListJobs RPC.
 	DeploymentServiceListJobsProcedure = "/jennah.v1.DeploymentService/ListJobs"
+	// DeploymentServiceRerunJobProcedure is the fully-qualified name of the DeploymentService's
+	// RerunJob RPC.
+	DeploymentServiceRerunJobProcedure = "/jennah.v1.DeploymentService/RerunJob"
+	// DeploymentServiceGetJobProcedure is the fully-qualified name of the DeploymentService's GetJob
+	// RPC.
+	DeploymentServiceGetJobProcedure = "/jennah.v1.DeploymentService/GetJob"
+	// DeploymentServiceGetJobHistoryProcedure is the fully-qualified name of the DeploymentService's
+	// GetJobHistory RPC.
+	DeploymentServiceGetJobHistoryProcedure = "/jennah.v1.DeploymentService/GetJobHistory"
+	// DeploymentServiceWatchJobsProcedure is the fully-qualified name of the DeploymentService's
+	// WatchJobs RPC.
+	DeploymentServiceWatchJobsProcedure = "/jennah.v1.DeploymentService/WatchJobs"
 	// DeploymentServiceGetCurrentTenantProcedure is the fully-qualified name of the DeploymentService's
 	// GetCurrentTenant RPC.
 	DeploymentServiceGetCurrentTenantProcedure = "/jennah.v1.DeploymentService/GetCurrentTenant"
@@ -48,20 +60,48 @@ const (
 	// DeploymentServiceDeleteJobProcedure is the fully-qualified name of the DeploymentService's
 	// DeleteJob RPC.
 	DeploymentServiceDeleteJobProcedure = "/jennah.v1.DeploymentService/DeleteJob"
+	// DeploymentServiceListJobAttemptsProcedure is the fully-qualified name of the DeploymentService's
+	// ListJobAttempts RPC.
+	DeploymentServiceListJobAttemptsProcedure = "/jennah.v1.DeploymentService/ListJobAttempts"
+	// DeploymentServiceGetJobLogsProcedure is the fully-qualified name of the DeploymentService's
+	// GetJobLogs RPC.
+	DeploymentServiceGetJobLogsProcedure = "/jennah.v1.DeploymentService/GetJobLogs"
+	// DeploymentServiceStreamJobLogsProcedure is the fully-qualified name of the DeploymentService's
+	// StreamJobLogs RPC.
+	DeploymentServiceStreamJobLogsProcedure = "/jennah.v1.DeploymentService/StreamJobLogs"
+	// DeploymentServiceListJobTasksProcedure is the fully-qualified name of the DeploymentService's
+	// ListJobTasks RPC.
+	DeploymentServiceListJobTasksProcedure = "/jennah.v1.DeploymentService/ListJobTasks"
 )
 
 // DeploymentServiceClient is a client for the jennah.v1.DeploymentService service.
 type DeploymentServiceClient interface {
 	// Submit a job for deployment.
 	SubmitJob(context.Context, *connect.Request[proto.SubmitJobRequest]) (*connect.Response[proto.SubmitJobResponse], error)
-	// List all jobs for the current tenant.
+	// List the current tenant's jobs, newest first, one page at a time.
 	ListJobs(context.Context, *connect.Request[proto.ListJobsRequest]) (*connect.Response[proto.ListJobsResponse], error)
+	// Submit a new job with the same spec as an existing one, optionally overlaid.
+	RerunJob(context.Context, *connect.Request[proto.RerunJobRequest]) (*connect.Response[proto.RerunJobResponse], error)
+	// Get a single job owned by the current tenant, including its full lifecycle record.
+	GetJob(context.Context, *connect.Request[proto.GetJobRequest]) (*connect.Response[proto.GetJobResponse], error)
+	// Get the status timeline of a job owned by the current tenant, oldest first.
+	GetJobHistory(context.Context, *connect.Request[proto.GetJobHistoryRequest]) (*connect.Response[proto.GetJobHistoryResponse], error)
+	// Stream status changes for one job, or for all of the current tenant's jobs.
+	WatchJobs(context.Context, *connect.Request[proto.WatchJobsRequest]) (*connect.ServerStreamForClient[proto.WatchJobsResponse], error)
 	// Get the current tenant's information.
 	GetCurrentTenant(context.Context, *connect.Request[proto.GetCurrentTenantRequest]) (*connect.Response[proto.GetCurrentTenantResponse], error)
 	// Cancel a job (only for PENDING, SCHEDULED, or RUNNING states).
 	CancelJob(context.Context, *connect.Request[proto.CancelJobRequest]) (*connect.Response[proto.CancelJobResponse], error)
-	// Delete a job from the system.
+	// Delete a finished job, or cancel and delete an active one with force.
 	DeleteJob(context.Context, *connect.Request[proto.DeleteJobRequest]) (*connect.Response[proto.DeleteJobResponse], error)
+	// List every submission attempt of a job owned by the current tenant, first attempt first.
+	ListJobAttempts(context.Context, *connect.Request[proto.ListJobAttemptsRequest]) (*connect.Response[proto.ListJobAttemptsResponse], error)
+	// Get one page of a job's output logs, oldest first.
+	GetJobLogs(context.Context, *connect.Request[proto.GetJobLogsRequest]) (*connect.Response[proto.GetJobLogsResponse], error)
+	// Stream a job's output logs as they are written, ending after the job finishes.
+	StreamJobLogs(context.Context, *connect.Request[proto.StreamJobLogsRequest]) (*connect.ServerStreamForClient[proto.StreamJobLogsResponse], error)
+	// List the tasks of a job owned by the current tenant with their statuses.
+	ListJobTasks(context.Context, *connect.Request[proto.ListJobTasksRequest]) (*connect.Response[proto.ListJobTasksResponse], error)
 }
 
 // NewDeploymentServiceClient constructs a client for the jennah.v1.DeploymentService service. By
@@ -87,6 +127,30 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(deploymentServiceMethods.ByName("ListJobs")),
 			connect.WithClientOptions(opts...),
 		),
+		rerunJob: connect.NewClient[proto.RerunJobRequest, proto.RerunJobResponse](
+			httpClient,
+			baseURL+DeploymentServiceRerunJobProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("RerunJob")),
+			connect.WithClientOptions(opts...),
+		),
+		getJob: connect.NewClient[proto.GetJobRequest, proto.GetJobResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetJobProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetJob")),
+			connect.WithClientOptions(opts...),
+		),
+		getJobHistory: connect.NewClient[proto.GetJobHistoryRequest, proto.GetJobHistoryResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetJobHistoryProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetJobHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		watchJobs: connect.NewClient[proto.WatchJobsRequest, proto.WatchJobsResponse](
+			httpClient,
+			baseURL+DeploymentServiceWatchJobsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("WatchJobs")),
+			connect.WithClientOptions(opts...),
+		),
 		getCurrentTenant: connect.NewClient[proto.GetCurrentTenantRequest, proto.GetCurrentTenantResponse](
 			httpClient,
 			baseURL+DeploymentServiceGetCurrentTenantProcedure,
@@ -105,6 +169,30 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(deploymentServiceMethods.ByName("DeleteJob")),
 			connect.WithClientOptions(opts...),
 		),
+		listJobAttempts: connect.NewClient[proto.ListJobAttemptsRequest, proto.ListJobAttemptsResponse](
+			httpClient,
+			baseURL+DeploymentServiceListJobAttemptsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListJobAttempts")),
+			connect.WithClientOptions(opts...),
+		),
+		getJobLogs: connect.NewClient[proto.GetJobLogsRequest, proto.GetJobLogsResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetJobLogsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetJobLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		streamJobLogs: connect.NewClient[proto.StreamJobLogsRequest, proto.StreamJobLogsResponse](
+			httpClient,
+			baseURL+DeploymentServiceStreamJobLogsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("StreamJobLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		listJobTasks: connect.NewClient[proto.ListJobTasksRequest, proto.ListJobTasksResponse](
+			httpClient,
+			baseURL+DeploymentServiceListJobTasksProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListJobTasks")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -112,9 +200,17 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 type deploymentServiceClient struct {
 	submitJob        *connect.Client[proto.SubmitJobRequest, proto.SubmitJobResponse]
 	listJobs         *connect.Client[proto.ListJobsRequest, proto.ListJobsResponse]
+	rerunJob         *connect.Client[proto.RerunJobRequest, proto.RerunJobResponse]
+	getJob           *connect.Client[proto.GetJobRequest, proto.GetJobResponse]
+	getJobHistory    *connect.Client[proto.GetJobHistoryRequest, proto.GetJobHistoryResponse]
+	watchJobs        *connect.Client[proto.WatchJobsRequest, proto.WatchJobsResponse]
 	getCurrentTenant *connect.Client[proto.GetCurrentTenantRequest, proto.GetCurrentTenantResponse]
 	cancelJob        *connect.Client[proto.CancelJobRequest, proto.CancelJobResponse]
 	deleteJob        *connect.Client[proto.DeleteJobRequest, proto.DeleteJobResponse]
+	listJobAttempts  *connect.Client[proto.ListJobAttemptsRequest, proto.ListJobAttemptsResponse]
+	getJobLogs       *connect.Client[proto.GetJobLogsRequest, proto.GetJobLogsResponse]
+	streamJobLogs    *connect.Client[proto.StreamJobLogsRequest, proto.StreamJobLogsResponse]
+	listJobTasks     *connect.Client[proto.ListJobTasksRequest, proto.ListJobTasksResponse]
 }
 
 // SubmitJob calls jennah.v1.DeploymentService.SubmitJob.
@@ -127,6 +223,26 @@ func (c *deploymentServiceClient) ListJobs(ctx context.Context, req *connect.Req
 	return c.listJobs.CallUnary(ctx, req)
 }
 
+// RerunJob calls jennah.v1.DeploymentService.RerunJob.
+func (c *deploymentServiceClient) RerunJob(ctx context.Context, req *connect.Request[proto.RerunJobRequest]) (*connect.Response[proto.RerunJobResponse], error) {
+	return c.rerunJob.CallUnary(ctx, req)
+}
+
+// GetJob calls jennah.v1.DeploymentService.GetJob.
+func (c *deploymentServiceClient) GetJob(ctx context.Context, req *connect.Request[proto.GetJobRequest]) (*connect.Response[proto.GetJobResponse], error) {
+	return c.getJob.CallUnary(ctx, req)
+}
+
+// GetJobHistory calls jennah.v1.DeploymentService.GetJobHistory.
+func (c *deploymentServiceClient) GetJobHistory(ctx context.Context, req *connect.Request[proto.GetJobHistoryRequest]) (*connect.Response[proto.GetJobHistoryResponse], error) {
+	return c.getJobHistory.CallUnary(ctx, req)
+}
+
+// WatchJobs calls jennah.v1.DeploymentService.WatchJobs.
+func (c *deploymentServiceClient) WatchJobs(ctx context.Context, req *connect.Request[proto.WatchJobsRequest]) (*connect.ServerStreamForClient[proto.WatchJobsResponse], error) {
+	return c.watchJobs.CallServerStream(ctx, req)
+}
+
 // GetCurrentTenant calls jennah.v1.DeploymentService.GetCurrentTenant.
 func (c *deploymentServiceClient) GetCurrentTenant(ctx context.Context, req *connect.Request[proto.GetCurrentTenantRequest]) (*connect.Response[proto.GetCurrentTenantResponse], error) {
 	return c.getCurrentTenant.CallUnary(ctx, req)
@@ -142,18 +258,54 @@ func (c *deploymentServiceClient) DeleteJob(ctx context.Context, req *connect.Re
 	return c.deleteJob.CallUnary(ctx, req)
 }
 
+// ListJobAttempts calls jennah.v1.DeploymentService.ListJobAttempts.
+func (c *deploymentServiceClient) ListJobAttempts(ctx context.Context, req *connect.Request[proto.ListJobAttemptsRequest]) (*connect.Response[proto.ListJobAttemptsResponse], error) {
+	return c.listJobAttempts.CallUnary(ctx, req)
+}
+
+// GetJobLogs calls jennah.v1.DeploymentService.GetJobLogs.
+func (c *deploymentServiceClient) GetJobLogs(ctx context.Context, req *connect.Request[proto.GetJobLogsRequest]) (*connect.Response[proto.GetJobLogsResponse], error) {
+	return c.getJobLogs.CallUnary(ctx, req)
+}
+
+// StreamJobLogs calls jennah.v1.DeploymentService.StreamJobLogs.
+func (c *deploymentServiceClient) StreamJobLogs(ctx context.Context, req *connect.Request[proto.StreamJobLogsRequest]) (*connect.ServerStreamForClient[proto.StreamJobLogsResponse], error) {
+	return c.streamJobLogs.CallServerStream(ctx, req)
+}
+
+// ListJobTasks calls jennah.v1.DeploymentService.ListJobTasks.
+func (c *deploymentServiceClient) ListJobTasks(ctx context.Context, req *connect.Request[proto.ListJobTasksRequest]) (*connect.Response[proto.ListJobTasksResponse], error) {
+	return c.listJobTasks.CallUnary(ctx, req)
+}
+
 // DeploymentServiceHandler is an implementation of the jennah.v1.DeploymentService service.
 type DeploymentServiceHandler interface {
 	// Submit a job for deployment.
 	SubmitJob(context.Context, *connect.Request[proto.SubmitJobRequest]) (*connect.Response[proto.SubmitJobResponse], error)
-	// List all jobs for the current tenant.
+	// List the current tenant's jobs, newest first, one page at a time.
 	ListJobs(context.Context, *connect.Request[proto.ListJobsRequest]) (*connect.Response[proto.ListJobsResponse], error)
+	// Submit a new job with the same spec as an existing one, optionally overlaid.
+	RerunJob(context.Context, *connect.Request[proto.RerunJobRequest]) (*connect.Response[proto.RerunJobResponse], error)
+	// Get a single job owned by the current tenant, including its full lifecycle record.
+	GetJob(context.Context, *connect.Request[proto.GetJobRequest]) (*connect.Response[proto.GetJobResponse], error)
+	// Get the status timeline of a job owned by the current tenant, oldest first.
+	GetJobHistory(context.Context, *connect.Request[proto.GetJobHistoryRequest]) (*connect.Response[proto.GetJobHistoryResponse], error)
+	// Stream status changes for one job, or for all of the current tenant's jobs.
+	WatchJobs(context.Context, *connect.Request[proto.WatchJobsRequest], *connect.ServerStream[proto.WatchJobsResponse]) error
 	// Get the current tenant's information.
 	GetCurrentTenant(context.Context, *connect.Request[proto.GetCurrentTenantRequest]) (*connect.Response[proto.GetCurrentTenantResponse], error)
 	// Cancel a job (only for PENDING, SCHEDULED, or RUNNING states).
 	CancelJob(context.Context, *connect.Request[proto.CancelJobRequest]) (*connect.Response[proto.CancelJobResponse], error)
-	// Delete a job from the system.
+	// Delete a finished job, or cancel and delete an active one with force.
 	DeleteJob(context.Context, *connect.Request[proto.DeleteJobRequest]) (*connect.Response[proto.DeleteJobResponse], error)
+	// List every submission attempt of a job owned by the current tenant, first attempt first.
+	ListJobAttempts(context.Context, *connect.Request[proto.ListJobAttemptsRequest]) (*connect.Response[proto.ListJobAttemptsResponse], error)
+	// Get one page of a job's output logs, oldest first.
+	GetJobLogs(context.Context, *connect.Request[proto.GetJobLogsRequest]) (*connect.Response[proto.GetJobLogsResponse], error)
+	// Stream a job's output logs as they are written, ending after the job finishes.
+	StreamJobLogs(context.Context, *connect.Request[proto.StreamJobLogsRequest], *connect.ServerStream[proto.StreamJobLogsResponse]) error
+	// List the tasks of a job owned by the current tenant with their statuses.
+	ListJobTasks(context.Context, *connect.Request[proto.ListJobTasksRequest]) (*connect.Response[proto.ListJobTasksResponse], error)
 }
 
 // NewDeploymentServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -175,6 +327,30 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		connect.WithSchema(deploymentServiceMethods.ByName("ListJobs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	deploymentServiceRerunJobHandler := connect.NewUnaryHandler(
+		DeploymentServiceRerunJobProcedure,
+		svc.RerunJob,
+		connect.WithSchema(deploymentServiceMethods.ByName("RerunJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetJobHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetJobProcedure,
+		svc.GetJob,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetJobHistoryHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetJobHistoryProcedure,
+		svc.GetJobHistory,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetJobHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceWatchJobsHandler := connect.NewServerStreamHandler(
+		DeploymentServiceWatchJobsProcedure,
+		svc.WatchJobs,
+		connect.WithSchema(deploymentServiceMethods.ByName("WatchJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	deploymentServiceGetCurrentTenantHandler := connect.NewUnaryHandler(
 		DeploymentServiceGetCurrentTenantProcedure,
 		svc.GetCurrentTenant,
@@ -193,18 +369,58 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		connect.WithSchema(deploymentServiceMethods.ByName("DeleteJob")),
 		connect.WithHandlerOptions(opts...),
 	)
+	deploymentServiceListJobAttemptsHandler := connect.NewUnaryHandler(
+		DeploymentServiceListJobAttemptsProcedure,
+		svc.ListJobAttempts,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListJobAttempts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetJobLogsHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetJobLogsProcedure,
+		svc.GetJobLogs,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetJobLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceStreamJobLogsHandler := connect.NewServerStreamHandler(
+		DeploymentServiceStreamJobLogsProcedure,
+		svc.StreamJobLogs,
+		connect.WithSchema(deploymentServiceMethods.ByName("StreamJobLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListJobTasksHandler := connect.NewUnaryHandler(
+		DeploymentServiceListJobTasksProcedure,
+		svc.ListJobTasks,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListJobTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/jennah.v1.DeploymentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DeploymentServiceSubmitJobProcedure:
 			deploymentServiceSubmitJobHandler.ServeHTTP(w, r)
 		case DeploymentServiceListJobsProcedure:
 			deploymentServiceListJobsHandler.ServeHTTP(w, r)
+		case DeploymentServiceRerunJobProcedure:
+			deploymentServiceRerunJobHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetJobProcedure:
+			deploymentServiceGetJobHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetJobHistoryProcedure:
+			deploymentServiceGetJobHistoryHandler.ServeHTTP(w, r)
+		case DeploymentServiceWatchJobsProcedure:
+			deploymentServiceWatchJobsHandler.ServeHTTP(w, r)
 		case DeploymentServiceGetCurrentTenantProcedure:
 			deploymentServiceGetCurrentTenantHandler.ServeHTTP(w, r)
 		case DeploymentServiceCancelJobProcedure:
 			deploymentServiceCancelJobHandler.ServeHTTP(w, r)
 		case DeploymentServiceDeleteJobProcedure:
 			deploymentServiceDeleteJobHandler.ServeHTTP(w, r)
+		case DeploymentServiceListJobAttemptsProcedure:
+			deploymentServiceListJobAttemptsHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetJobLogsProcedure:
+			deploymentServiceGetJobLogsHandler.ServeHTTP(w, r)
+		case DeploymentServiceStreamJobLogsProcedure:
+			deploymentServiceStreamJobLogsHandler.ServeHTTP(w, r)
+		case DeploymentServiceListJobTasksProcedure:
+			deploymentServiceListJobTasksHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -222,6 +438,22 @@ func (UnimplementedDeploymentServiceHandler) ListJobs(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListJobs is not implemented"))
 }
 
+func (UnimplementedDeploymentServiceHandler) RerunJob(context.Context, *connect.Request[proto.RerunJobRequest]) (*connect.Response[proto.RerunJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.RerunJob is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetJob(context.Context, *connect.Request[proto.GetJobRequest]) (*connect.Response[proto.GetJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.GetJob is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetJobHistory(context.Context, *connect.Request[proto.GetJobHistoryRequest]) (*connect.Response[proto.GetJobHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.GetJobHistory is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) WatchJobs(context.Context, *connect.Request[proto.WatchJobsRequest], *connect.ServerStream[proto.WatchJobsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.WatchJobs is not implemented"))
+}
+
 func (UnimplementedDeploymentServiceHandler) GetCurrentTenant(context.Context, *connect.Request[proto.GetCurrentTenantRequest]) (*connect.Response[proto.GetCurrentTenantResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.GetCurrentTenant is not implemented"))
 }
@@ -233,3 +465,19 @@ func (UnimplementedDeploymentServiceHandler) CancelJob(context.Context, *connect
 func (UnimplementedDeploymentServiceHandler) DeleteJob(context.Context, *connect.Request[proto.DeleteJobRequest]) (*connect.Response[proto.DeleteJobResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.DeleteJob is not implemented"))
 }
+
+func (UnimplementedDeploymentServiceHandler) ListJobAttempts(context.Context, *connect.Request[proto.ListJobAttemptsRequest]) (*connect.Response[proto.ListJobAttemptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListJobAttempts is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetJobLogs(context.Context, *connect.Request[proto.GetJobLogsRequest]) (*connect.Response[proto.GetJobLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.GetJobLogs is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) StreamJobLogs(context.Context, *connect.Request[proto.StreamJobLogsRequest], *connect.ServerStream[proto.StreamJobLogsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.StreamJobLogs is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListJobTasks(context.Context, *connect.Request[proto.ListJobTasksRequest]) (*connect.Response[proto.ListJobTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListJobTasks is not implemented"))
+}
@@ -98,7 +98,7 @@ func (p *AWSBatchProvider) SubmitJob(ctx context.Context, config batchpkg.JobCon
 
 // GetJobStatus retrieves the current status of an AWS Batch job.
 // NOTE: Stub implementation - returns not implemented error.
-func (p *AWSBatchProvider) GetJobStatus(ctx context.Context, cloudResourcePath string) (batchpkg.JobStatus, error) {
+func (p *AWSBatchProvider) GetJobStatus(ctx context.Context, cloudResourcePath string) (*batchpkg.JobStatusInfo, error) {
 	// Full implementation would:
 	// 1. Extract job ID from ARN
 	// 2. Call DescribeJobs API
@@ -111,8 +111,9 @@ func (p *AWSBatchProvider) GetJobStatus(ctx context.Context, cloudResourcePath s
 	//   RUNNING -> JobStatusRunning
 	//   SUCCEEDED -> JobStatusCompleted
 	//   FAILED -> JobStatusFailed
+	// The exit code comes from the job's container.exitCode.
 
-	return batchpkg.JobStatusUnknown, fmt.Errorf("AWS Batch provider not fully implemented yet")
+	return nil, fmt.Errorf("AWS Batch provider not fully implemented yet")
 }
 
 // CancelJob cancels a running AWS Batch job.
@@ -131,6 +132,15 @@ func (p *AWSBatchProvider) CancelJob(ctx context.Context, cloudResourcePath stri
 	return fmt.Errorf("AWS Batch provider not fully implemented yet")
 }
 
+// ListTasks lists the child jobs of an AWS Batch array job.
+// NOTE: Stub implementation - returns not implemented error.
+func (p *AWSBatchProvider) ListTasks(ctx context.Context, cloudResourcePath string) ([]batchpkg.TaskStatusInfo, error) {
+	// Full implementation would call ListJobs with ArrayJobId set to the job
+	// ID and map each child's arrayProperties.index and status.
+
+	return nil, fmt.Errorf("AWS Batch provider not fully implemented yet")
+}
+
 // ListJobs lists all jobs in the AWS account/region.
 // NOTE: Stub implementation - returns not implemented error.
 func (p *AWSBatchProvider) ListJobs(ctx context.Context) ([]string, error) {
@@ -0,0 +1,237 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	batchpkg "github.com/alphauslabs/jennah/internal/batch"
+)
+
+func init() {
+	// Register fake provider constructor
+	batchpkg.RegisterFakeProvider(NewFakeBatchProvider)
+}
+
+// defaultLifecycle is the sequence of states a fake job walks through,
+// advancing one step every time its status is polled.
+var defaultLifecycle = []batchpkg.JobStatus{
+	batchpkg.JobStatusPending,
+	batchpkg.JobStatusScheduled,
+	batchpkg.JobStatusRunning,
+	batchpkg.JobStatusCompleted,
+}
+
+// FakeBatchProvider implements the batch.Provider interface entirely in memory.
+// It is meant for running the worker and reconciler offline (e.g. against the
+// Spanner emulator) without touching a real cloud batch service.
+type FakeBatchProvider struct {
+	mu        sync.Mutex
+	region    string
+	lifecycle []batchpkg.JobStatus
+	jobs      map[string]*fakeJob
+}
+
+type fakeJob struct {
+	config batchpkg.JobConfig
+	step   int
+	// override pins the job to a fixed status once set via SetJobStatus.
+	override batchpkg.JobStatus
+	// last is the status most recently reported by GetJobStatus.
+	last batchpkg.JobStatus
+}
+
+// NewFakeBatchProvider creates a new in-memory batch provider.
+// ProviderOptions["outcome"] may be set to "FAILED" or "CANCELLED" to change the
+// terminal state every job ends in (defaults to COMPLETED).
+func NewFakeBatchProvider(ctx context.Context, config batchpkg.ProviderConfig) (batchpkg.Provider, error) {
+	lifecycle := append([]batchpkg.JobStatus(nil), defaultLifecycle...)
+
+	switch outcome := batchpkg.JobStatus(config.ProviderOptions["outcome"]); outcome {
+	case "", batchpkg.JobStatusCompleted:
+	case batchpkg.JobStatusFailed, batchpkg.JobStatusCancelled:
+		lifecycle[len(lifecycle)-1] = outcome
+	default:
+		return nil, fmt.Errorf("unsupported outcome for fake batch provider: %s", outcome)
+	}
+
+	region := config.Region
+	if region == "" {
+		region = "local"
+	}
+
+	return &FakeBatchProvider{
+		region:    region,
+		lifecycle: lifecycle,
+		jobs:      make(map[string]*fakeJob),
+	}, nil
+}
+
+// SubmitJob records the job in memory and reports it as PENDING.
+func (p *FakeBatchProvider) SubmitJob(ctx context.Context, config batchpkg.JobConfig) (*batchpkg.JobResult, error) {
+	if config.JobID == "" {
+		return nil, fmt.Errorf("job id is required")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	path := p.resourcePath(config.JobID)
+	if _, exists := p.jobs[path]; exists {
+		return nil, fmt.Errorf("fake batch job already exists: %s", path)
+	}
+	p.jobs[path] = &fakeJob{config: config, last: p.lifecycle[0]}
+
+	return &batchpkg.JobResult{
+		CloudResourcePath: path,
+		ProviderJobUID:    "uid-" + config.JobID,
+		InitialStatus:     p.lifecycle[0],
+	}, nil
+}
+
+// GetJobStatus returns the job's current state and advances it one step.
+// Completed jobs report exit code 0 and failed jobs exit code 1 with a
+// USER_ERROR failure category.
+func (p *FakeBatchProvider) GetJobStatus(ctx context.Context, cloudResourcePath string) (*batchpkg.JobStatusInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	job, ok := p.jobs[cloudResourcePath]
+	if !ok {
+		return nil, fmt.Errorf("fake batch job not found: %s", cloudResourcePath)
+	}
+
+	status := job.override
+	if status == "" {
+		status = p.lifecycle[job.step]
+		if job.step < len(p.lifecycle)-1 {
+			job.step++
+		}
+	}
+
+	job.last = status
+
+	info := &batchpkg.JobStatusInfo{Status: status}
+	switch status {
+	case batchpkg.JobStatusCompleted:
+		exitCode := int32(0)
+		info.ExitCode = &exitCode
+	case batchpkg.JobStatusFailed:
+		exitCode := int32(1)
+		info.ExitCode = &exitCode
+		info.FailureCategory = batchpkg.FailureCategoryUserError
+		info.Events = []batchpkg.StatusEvent{{
+			Time:        time.Now(),
+			Type:        "STATUS_CHANGED",
+			Description: "fake task exited with code 1",
+		}}
+	}
+	return info, nil
+}
+
+// CancelJob marks the job as CANCELLED.
+func (p *FakeBatchProvider) CancelJob(ctx context.Context, cloudResourcePath string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	job, ok := p.jobs[cloudResourcePath]
+	if !ok {
+		return fmt.Errorf("fake batch job not found: %s", cloudResourcePath)
+	}
+	job.override = batchpkg.JobStatusCancelled
+	return nil
+}
+
+// ListTasks derives task statuses from the job's last reported status without
+// advancing it. While RUNNING, the first Parallelism tasks run and the rest
+// wait; a FAILED job has task 0 failed and the others completed.
+func (p *FakeBatchProvider) ListTasks(ctx context.Context, cloudResourcePath string) ([]batchpkg.TaskStatusInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	job, ok := p.jobs[cloudResourcePath]
+	if !ok {
+		return nil, fmt.Errorf("fake batch job not found: %s", cloudResourcePath)
+	}
+
+	status := job.override
+	if status == "" {
+		status = job.last
+	}
+	count := max(job.config.TaskCount, 1)
+	parallelism := job.config.Parallelism
+	if parallelism <= 0 {
+		parallelism = count
+	}
+
+	tasks := make([]batchpkg.TaskStatusInfo, count)
+	for i := range tasks {
+		task := batchpkg.TaskStatusInfo{Index: int64(i), Status: status}
+		switch {
+		case status == batchpkg.JobStatusRunning && int64(i) >= parallelism:
+			task.Status = batchpkg.JobStatusPending
+		case status == batchpkg.JobStatusFailed && i > 0:
+			task.Status = batchpkg.JobStatusCompleted
+		}
+		switch task.Status {
+		case batchpkg.JobStatusCompleted:
+			exitCode := int32(0)
+			task.ExitCode = &exitCode
+		case batchpkg.JobStatusFailed:
+			exitCode := int32(1)
+			task.ExitCode = &exitCode
+			task.Message = "fake task exited with code 1"
+		}
+		tasks[i] = task
+	}
+	return tasks, nil
+}
+
+// ListJobs returns the resource paths of every job submitted to this provider.
+func (p *FakeBatchProvider) ListJobs(ctx context.Context) ([]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	paths := make([]string, 0, len(p.jobs))
+	for path := range p.jobs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// SetJobStatus pins a job to the given status, overriding its lifecycle.
+func (p *FakeBatchProvider) SetJobStatus(cloudResourcePath string, status batchpkg.JobStatus) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	job, ok := p.jobs[cloudResourcePath]
+	if !ok {
+		return fmt.Errorf("fake batch job not found: %s", cloudResourcePath)
+	}
+	job.override = status
+	return nil
+}
+
+// JobConfig returns the configuration a job was submitted with.
+func (p *FakeBatchProvider) JobConfig(cloudResourcePath string) (batchpkg.JobConfig, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	job, ok := p.jobs[cloudResourcePath]
+	if !ok {
+		return batchpkg.JobConfig{}, false
+	}
+	return job.config, true
+}
+
+// Close is a no-op for the fake provider.
+func (p *FakeBatchProvider) Close() error {
+	return nil
+}
+
+func (p *FakeBatchProvider) resourcePath(jobID string) string {
+	return fmt.Sprintf("fake://%s/jobs/%s", p.region, jobID)
+}
@@ -0,0 +1,119 @@
+package fake
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	batchpkg "github.com/alphauslabs/jennah/internal/batch"
+)
+
+func init() {
+	// Register fake log source constructor
+	batchpkg.RegisterFakeLogSource(NewFileLogSource)
+}
+
+const defaultLogPageSize = 500
+
+// FileLogSource implements batch.LogSource by reading plain text files, so job
+// logs can be exercised offline. The logs of a job live in
+// "<log_dir>/<job name>.log", where the job name is the last segment of its
+// cloud resource path. Lines starting with an RFC 3339 timestamp use it as
+// the entry time; other lines take the time of the line before them (the
+// file's modification time for leading lines), so follow mode works best
+// with timestamped files.
+type FileLogSource struct {
+	dir string
+}
+
+// NewFileLogSource creates a file-backed log source.
+// ProviderOptions["log_dir"] sets the directory (defaults to the current directory).
+func NewFileLogSource(ctx context.Context, config batchpkg.ProviderConfig) (batchpkg.LogSource, error) {
+	dir := config.ProviderOptions["log_dir"]
+	if dir == "" {
+		dir = "."
+	}
+	return &FileLogSource{dir: dir}, nil
+}
+
+// ReadLogs returns one page of the job's log file. Entry IDs are line numbers
+// and page tokens are the line to continue from. A job without a log file
+// has no entries yet.
+func (s *FileLogSource) ReadLogs(ctx context.Context, req batchpkg.LogRequest) (*batchpkg.LogPage, error) {
+	name := path.Base(req.CloudResourcePath)
+	if name == "" || name == "." || name == "/" {
+		return nil, fmt.Errorf("invalid cloud resource path: %s", req.CloudResourcePath)
+	}
+
+	offset := 0
+	if req.PageToken != "" {
+		n, err := strconv.Atoi(req.PageToken)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid page token: %s", req.PageToken)
+		}
+		offset = n
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultLogPageSize
+	}
+
+	file, err := os.Open(filepath.Join(s.dir, name+".log"))
+	if os.IsNotExist(err) {
+		return &batchpkg.LogPage{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	page := &batchpkg.LogPage{}
+	scanner := bufio.NewScanner(file)
+	line := 0
+	last := stat.ModTime()
+	for scanner.Scan() {
+		line++
+		entry := parseLogLine(scanner.Text(), last)
+		last = entry.Timestamp
+		if line <= offset {
+			continue
+		}
+		if len(page.Entries) == pageSize {
+			page.NextPageToken = strconv.Itoa(line - 1)
+			break
+		}
+
+		if !req.Since.IsZero() && entry.Timestamp.Before(req.Since) {
+			continue
+		}
+		entry.ID = strconv.Itoa(line)
+		page.Entries = append(page.Entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read log file: %w", err)
+	}
+	return page, nil
+}
+
+// parseLogLine splits "<RFC 3339 time> <message>" into an entry, falling back
+// to the whole line at the given time.
+func parseLogLine(text string, fallback time.Time) batchpkg.LogEntry {
+	if ts, message, ok := strings.Cut(text, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			return batchpkg.LogEntry{Timestamp: t, Severity: "INFO", Message: message}
+		}
+	}
+	return batchpkg.LogEntry{Timestamp: fallback, Severity: "INFO", Message: text}
+}
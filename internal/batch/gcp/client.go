@@ -3,10 +3,16 @@ package gcp
 import (
 	"context"
 	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	batch "cloud.google.com/go/batch/apiv1"
 	"cloud.google.com/go/batch/apiv1/batchpb"
+	"google.golang.org/api/iterator"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	batchpkg "github.com/alphauslabs/jennah/internal/batch"
@@ -49,25 +55,55 @@ func NewGCPBatchProvider(ctx context.Context, config batchpkg.ProviderConfig) (b
 func (p *GCPBatchProvider) SubmitJob(ctx context.Context, config batchpkg.JobConfig) (*batchpkg.JobResult, error) {
 	parent := fmt.Sprintf("projects/%s/locations/%s", p.projectID, p.region)
 
-	// Create runnable with container configuration
-	runnable := &batchpb.Runnable{
-		Executable: &batchpb.Runnable_Container_{
-			Container: &batchpb.Runnable_Container{
-				ImageUri: config.ImageURI,
-			},
-		},
+	// A single-runnable job is a job with one step.
+	steps := config.Steps
+	if len(steps) == 0 {
+		steps = []batchpkg.Step{{
+			ImageURI:   config.ImageURI,
+			Commands:   config.Commands,
+			Entrypoint: config.Entrypoint,
+			WorkingDir: config.WorkingDir,
+			User:       config.User,
+			Script:     config.Script,
+		}}
 	}
-
-	// Add environment variables if provided
-	if len(config.EnvVars) > 0 {
-		runnable.Environment = &batchpb.Environment{
-			Variables: config.EnvVars,
-		}
+	runnables := make([]*batchpb.Runnable, 0, len(steps))
+	for _, step := range steps {
+		runnables = append(runnables, newRunnable(step))
 	}
 
 	// Create task specification
 	taskSpec := &batchpb.TaskSpec{
-		Runnables: []*batchpb.Runnable{runnable},
+		Runnables:     runnables,
+		MaxRetryCount: config.MaxTaskRetries,
+	}
+
+	// Job env vars apply to every runnable. Batch reads secret variables
+	// from Secret Manager on the VM.
+	if len(config.EnvVars) > 0 || len(config.SecretEnvVars) > 0 {
+		taskSpec.Environment = &batchpb.Environment{
+			Variables:       config.EnvVars,
+			SecretVariables: config.SecretEnvVars,
+		}
+	}
+	for _, policy := range config.LifecyclePolicies {
+		taskSpec.LifecyclePolicies = append(taskSpec.LifecyclePolicies, &batchpb.LifecyclePolicy{
+			Action: mapLifecycleAction(policy.Action),
+			ActionCondition: &batchpb.LifecyclePolicy_ActionCondition{
+				ExitCodes: policy.ExitCodes,
+			},
+		})
+	}
+
+	// Disk volumes are created per task through the instance policy and
+	// mounted by device name.
+	var disks []*batchpb.AllocationPolicy_AttachedDisk
+	for _, volume := range config.Volumes {
+		v, disk := newVolume(volume, len(disks))
+		taskSpec.Volumes = append(taskSpec.Volumes, v)
+		if disk != nil {
+			disks = append(disks, disk)
+		}
 	}
 
 	// Add resource requirements if specified
@@ -87,14 +123,18 @@ func (p *GCPBatchProvider) SubmitJob(ctx context.Context, config batchpkg.JobCon
 	job := &batchpb.Job{
 		TaskGroups: []*batchpb.TaskGroup{
 			{
-				TaskSpec:  taskSpec,
-				TaskCount: 1,
+				TaskSpec:    taskSpec,
+				TaskCount:   max(config.TaskCount, 1),
+				Parallelism: config.Parallelism,
 			},
 		},
 		LogsPolicy: &batchpb.LogsPolicy{
 			Destination: batchpb.LogsPolicy_CLOUD_LOGGING,
 		},
+		Labels:   config.Labels,
+		Priority: config.Priority,
 	}
+	job.AllocationPolicy = newAllocationPolicy(config.Allocation, disks)
 
 	// Submit job to GCP Batch
 	req := &batchpb.CreateJobRequest{
@@ -113,22 +153,122 @@ func (p *GCPBatchProvider) SubmitJob(ctx context.Context, config batchpkg.JobCon
 
 	return &batchpkg.JobResult{
 		CloudResourcePath: batchJob.Name,
+		ProviderJobUID:    batchJob.Uid,
 		InitialStatus:     initialStatus,
 	}, nil
 }
 
 // GetJobStatus retrieves the current status of a GCP Batch job.
-func (p *GCPBatchProvider) GetJobStatus(ctx context.Context, cloudResourcePath string) (batchpkg.JobStatus, error) {
+func (p *GCPBatchProvider) GetJobStatus(ctx context.Context, cloudResourcePath string) (*batchpkg.JobStatusInfo, error) {
 	req := &batchpb.GetJobRequest{
 		Name: cloudResourcePath,
 	}
 
 	job, err := p.client.GetJob(ctx, req)
 	if err != nil {
-		return batchpkg.JobStatusUnknown, fmt.Errorf("failed to get GCP Batch job: %w", err)
+		return nil, fmt.Errorf("failed to get GCP Batch job: %w", err)
+	}
+
+	info := &batchpkg.JobStatusInfo{
+		Status: mapGCPStatusToJennah(job.Status.State),
+		Events: recentStatusEvents(job.Status),
+	}
+	switch job.Status.State {
+	case batchpb.JobStatus_SUCCEEDED:
+		exitCode := int32(0)
+		info.ExitCode = &exitCode
+	case batchpb.JobStatus_FAILED:
+		info.ExitCode = lastTaskExitCode(job.Status)
+		info.FailureCategory = classifyFailure(info.ExitCode, info.Events)
+	}
+	return info, nil
+}
+
+// maxStatusEvents is how many of a job's most recent status events are reported.
+const maxStatusEvents = 10
+
+// GCP Batch reserved exit codes.
+// See https://cloud.google.com/batch/docs/troubleshooting#reserved-exit-codes.
+const (
+	exitCodeVMPreempted    = 50001
+	exitCodeMaxRunDuration = 50005
+)
+
+// exitCodeOOMKilled is the exit code of a container killed with SIGKILL,
+// which is how the kernel OOM killer ends it.
+const exitCodeOOMKilled = 137
+
+// recentStatusEvents returns the job's last maxStatusEvents status events, oldest first.
+func recentStatusEvents(status *batchpb.JobStatus) []batchpkg.StatusEvent {
+	events := make([]batchpkg.StatusEvent, 0, len(status.StatusEvents))
+	for _, event := range status.StatusEvents {
+		events = append(events, batchpkg.StatusEvent{
+			Time:        event.EventTime.AsTime(),
+			Type:        event.Type,
+			Description: event.Description,
+		})
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+	if len(events) > maxStatusEvents {
+		events = events[len(events)-maxStatusEvents:]
+	}
+	return events
+}
+
+// oomPattern matches "OOM" as a word (including "OOMKilled") but not inside
+// words such as "room" or "zoom".
+var oomPattern = regexp.MustCompile(`\boom(killed)?\b`)
+
+// classifyFailure picks a failure category from the exit code and the
+// wording of the job's status events.
+func classifyFailure(exitCode *int32, events []batchpkg.StatusEvent) batchpkg.FailureCategory {
+	for _, event := range events {
+		text := strings.ToLower(event.Description)
+		switch {
+		case strings.Contains(text, "preempt"):
+			// e.g. "... due to Spot VM preemption with exit code 50001."
+			return batchpkg.FailureCategoryPreempted
+		case strings.Contains(text, "pull") && strings.Contains(text, "image"):
+			return batchpkg.FailureCategoryImagePull
+		case strings.Contains(text, "out of memory") || oomPattern.MatchString(text):
+			return batchpkg.FailureCategoryOutOfMemory
+		}
 	}
 
-	return mapGCPStatusToJennah(job.Status.State), nil
+	if exitCode == nil {
+		return batchpkg.FailureCategoryUnknown
+	}
+	switch *exitCode {
+	case exitCodeVMPreempted:
+		return batchpkg.FailureCategoryPreempted
+	case exitCodeMaxRunDuration:
+		return batchpkg.FailureCategoryTimeout
+	case exitCodeOOMKilled:
+		return batchpkg.FailureCategoryOutOfMemory
+	}
+	if *exitCode > 0 && *exitCode < 50000 {
+		return batchpkg.FailureCategoryUserError
+	}
+	return batchpkg.FailureCategoryUnknown
+}
+
+// lastTaskExitCode returns the exit code of the most recent failed task
+// execution in the job's status events, or nil if none is recorded.
+func lastTaskExitCode(status *batchpb.JobStatus) *int32 {
+	var exitCode *int32
+	var latest time.Time
+	for _, event := range status.StatusEvents {
+		if event.TaskExecution == nil {
+			continue
+		}
+		at := event.EventTime.AsTime()
+		if exitCode == nil || !at.Before(latest) {
+			code := event.TaskExecution.ExitCode
+			exitCode = &code
+			latest = at
+		}
+	}
+	return exitCode
 }
 
 // CancelJob cancels a running GCP Batch job.
@@ -150,6 +290,73 @@ func (p *GCPBatchProvider) CancelJob(ctx context.Context, cloudResourcePath stri
 	return nil
 }
 
+// defaultTaskGroup is the name GCP Batch gives a job's only task group.
+const defaultTaskGroup = "group0"
+
+// ListTasks returns the status of every task of a GCP Batch job.
+func (p *GCPBatchProvider) ListTasks(ctx context.Context, cloudResourcePath string) ([]batchpkg.TaskStatusInfo, error) {
+	it := p.client.ListTasks(ctx, &batchpb.ListTasksRequest{
+		Parent: cloudResourcePath + "/taskGroups/" + defaultTaskGroup,
+	})
+
+	var tasks []batchpkg.TaskStatusInfo
+	for {
+		task, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list GCP Batch tasks: %w", err)
+		}
+
+		// Task names end in ".../tasks/<index>".
+		index, err := strconv.ParseInt(path.Base(task.Name), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected GCP Batch task name %q", task.Name)
+		}
+		info := batchpkg.TaskStatusInfo{
+			Index:  index,
+			Status: mapGCPTaskStatusToJennah(task.Status.GetState()),
+		}
+		var latest time.Time
+		for _, event := range task.Status.GetStatusEvents() {
+			at := event.EventTime.AsTime()
+			if at.Before(latest) {
+				continue
+			}
+			latest = at
+			info.Message = event.Description
+			if event.TaskExecution != nil {
+				code := event.TaskExecution.ExitCode
+				info.ExitCode = &code
+			}
+		}
+		tasks = append(tasks, info)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].Index < tasks[j].Index })
+	return tasks, nil
+}
+
+// mapGCPTaskStatusToJennah maps a GCP Batch task state to a Jennah status.
+func mapGCPTaskStatusToJennah(state batchpb.TaskStatus_State) batchpkg.JobStatus {
+	switch state {
+	case batchpb.TaskStatus_PENDING:
+		return batchpkg.JobStatusPending
+	case batchpb.TaskStatus_ASSIGNED:
+		return batchpkg.JobStatusScheduled
+	case batchpb.TaskStatus_RUNNING:
+		return batchpkg.JobStatusRunning
+	case batchpb.TaskStatus_SUCCEEDED:
+		return batchpkg.JobStatusCompleted
+	case batchpb.TaskStatus_FAILED:
+		return batchpkg.JobStatusFailed
+	case batchpb.TaskStatus_UNEXECUTED:
+		return batchpkg.JobStatusCancelled
+	default:
+		return batchpkg.JobStatusUnknown
+	}
+}
+
 // ListJobs lists all jobs in the GCP project/region.
 func (p *GCPBatchProvider) ListJobs(ctx context.Context) ([]string, error) {
 	parent := fmt.Sprintf("projects/%s/locations/%s", p.projectID, p.region)
@@ -178,6 +385,167 @@ func (p *GCPBatchProvider) Close() error {
 	return p.client.Close()
 }
 
+// newRunnable maps a job step to a GCP Batch runnable.
+func newRunnable(step batchpkg.Step) *batchpb.Runnable {
+	runnable := &batchpb.Runnable{
+		DisplayName:      step.Name,
+		Background:       step.Background,
+		IgnoreExitStatus: step.IgnoreExitStatus,
+	}
+	switch {
+	case step.Barrier:
+		runnable.Executable = &batchpb.Runnable_Barrier_{
+			Barrier: &batchpb.Runnable_Barrier{Name: step.Name},
+		}
+	case step.Script != nil:
+		runnable.Executable = &batchpb.Runnable_Script_{
+			Script: &batchpb.Runnable_Script{
+				Command: &batchpb.Runnable_Script_Text{Text: scriptText(step.Script)},
+			},
+		}
+	default:
+		runnable.Executable = &batchpb.Runnable_Container_{
+			Container: &batchpb.Runnable_Container{
+				ImageUri:   step.ImageURI,
+				Commands:   step.Commands,
+				Entrypoint: step.Entrypoint,
+				Options:    containerOptions(step.WorkingDir, step.User),
+			},
+		}
+	}
+	if len(step.EnvVars) > 0 {
+		runnable.Environment = &batchpb.Environment{
+			Variables: step.EnvVars,
+		}
+	}
+	return runnable
+}
+
+// newAllocationPolicy builds the job's allocation policy from the requested
+// VMs and the disks to attach to them. It returns nil when neither is set.
+func newAllocationPolicy(allocation *batchpkg.AllocationPolicy, disks []*batchpb.AllocationPolicy_AttachedDisk) *batchpb.AllocationPolicy {
+	if allocation == nil && len(disks) == 0 {
+		return nil
+	}
+
+	instancePolicy := &batchpb.AllocationPolicy_InstancePolicy{Disks: disks}
+	policy := &batchpb.AllocationPolicy{
+		Instances: []*batchpb.AllocationPolicy_InstancePolicyOrTemplate{{
+			PolicyTemplate: &batchpb.AllocationPolicy_InstancePolicyOrTemplate_Policy{
+				Policy: instancePolicy,
+			},
+		}},
+	}
+	if allocation != nil {
+		instancePolicy.MachineType = allocation.MachineType
+		instancePolicy.ProvisioningModel = mapProvisioningModel(allocation.ProvisioningModel)
+		for _, zone := range allocation.Zones {
+			if policy.Location == nil {
+				policy.Location = &batchpb.AllocationPolicy_LocationPolicy{}
+			}
+			policy.Location.AllowedLocations = append(policy.Location.AllowedLocations, "zones/"+zone)
+		}
+	}
+	return policy
+}
+
+// mapProvisioningModel converts a provisioning model to GCP's. Empty leaves
+// the choice to GCP (standard VMs).
+func mapProvisioningModel(model batchpkg.ProvisioningModel) batchpb.AllocationPolicy_ProvisioningModel {
+	switch model {
+	case batchpkg.ProvisioningModelStandard:
+		return batchpb.AllocationPolicy_STANDARD
+	case batchpkg.ProvisioningModelSpot:
+		return batchpb.AllocationPolicy_SPOT
+	default:
+		return batchpb.AllocationPolicy_PROVISIONING_MODEL_UNSPECIFIED
+	}
+}
+
+// newVolume converts a volume to its GCP form. Disk volumes also return the
+// disk to attach, named after diskIndex.
+func newVolume(volume batchpkg.Volume, diskIndex int) (*batchpb.Volume, *batchpb.AllocationPolicy_AttachedDisk) {
+	v := &batchpb.Volume{MountPath: volume.MountPath}
+	switch {
+	case volume.GCS != nil:
+		remotePath := volume.GCS.Bucket
+		if volume.GCS.Path != "" {
+			remotePath += "/" + volume.GCS.Path
+		}
+		v.Source = &batchpb.Volume_Gcs{Gcs: &batchpb.GCS{RemotePath: remotePath}}
+		if volume.ReadOnly {
+			// Options are passed to gcsfuse.
+			v.MountOptions = []string{"-o ro"}
+		}
+	case volume.NFS != nil:
+		v.Source = &batchpb.Volume_Nfs{Nfs: &batchpb.NFS{
+			Server:     volume.NFS.Server,
+			RemotePath: volume.NFS.RemotePath,
+		}}
+		if volume.ReadOnly {
+			v.MountOptions = []string{"ro"}
+		}
+	case volume.Disk != nil:
+		deviceName := fmt.Sprintf("jennah-disk-%d", diskIndex)
+		v.Source = &batchpb.Volume_DeviceName{DeviceName: deviceName}
+		diskType := volume.Disk.Type
+		if diskType == "" {
+			diskType = "pd-balanced"
+		}
+		return v, &batchpb.AllocationPolicy_AttachedDisk{
+			Attached: &batchpb.AllocationPolicy_AttachedDisk_NewDisk{
+				NewDisk: &batchpb.AllocationPolicy_Disk{Type: diskType, SizeGb: volume.Disk.SizeGB},
+			},
+			DeviceName: deviceName,
+		}
+	}
+	return v, nil
+}
+
+// containerOptions renders the working directory and user as the docker run
+// flags GCP Batch accepts in Runnable_Container.Options. Values are validated
+// by the worker and never contain whitespace.
+func containerOptions(workingDir, user string) string {
+	var options []string
+	if workingDir != "" {
+		options = append(options, "--workdir="+workingDir)
+	}
+	if user != "" {
+		options = append(options, "--user="+user)
+	}
+	return strings.Join(options, " ")
+}
+
+// scriptText returns the script body GCP Batch runs. Batch picks the
+// interpreter from a leading "#!" line, so an explicit interpreter replaces
+// any shebang the text already has.
+func scriptText(script *batchpkg.Script) string {
+	if script.Interpreter == "" {
+		return script.Text
+	}
+	text := script.Text
+	if strings.HasPrefix(text, "#!") {
+		if _, rest, ok := strings.Cut(text, "\n"); ok {
+			text = rest
+		} else {
+			text = ""
+		}
+	}
+	return "#!" + script.Interpreter + "\n" + text
+}
+
+// mapLifecycleAction maps a Jennah lifecycle action to its GCP Batch equivalent.
+func mapLifecycleAction(action batchpkg.LifecycleAction) batchpb.LifecyclePolicy_Action {
+	switch action {
+	case batchpkg.LifecycleActionRetryTask:
+		return batchpb.LifecyclePolicy_RETRY_TASK
+	case batchpkg.LifecycleActionFailTask:
+		return batchpb.LifecyclePolicy_FAIL_TASK
+	default:
+		return batchpb.LifecyclePolicy_ACTION_UNSPECIFIED
+	}
+}
+
 // mapGCPStatusToJennah maps GCP Batch job states to Jennah status constants.
 func mapGCPStatusToJennah(state batchpb.JobStatus_State) batchpkg.JobStatus {
 	switch state {
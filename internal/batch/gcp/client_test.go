@@ -0,0 +1,35 @@
+package gcp
+
+import (
+	"testing"
+
+	batchpkg "github.com/alphauslabs/jennah/internal/batch"
+)
+
+func TestClassifyFailure(t *testing.T) {
+	code := func(c int32) *int32 { return &c }
+	tests := []struct {
+		description string
+		exitCode    *int32
+		want        batchpkg.FailureCategory
+	}{
+		{"Job state is set from RUNNING to FAILED because task was OOMKilled.", nil, batchpkg.FailureCategoryOutOfMemory},
+		{"container ran out of memory", nil, batchpkg.FailureCategoryOutOfMemory},
+		{"task failed: oom", nil, batchpkg.FailureCategoryOutOfMemory},
+		{"Task exited with code 137", code(137), batchpkg.FailureCategoryOutOfMemory},
+		{"no room left on device", code(1), batchpkg.FailureCategoryUserError},
+		{"zoom export failed", code(2), batchpkg.FailureCategoryUserError},
+		{"bloom filter build failed", nil, batchpkg.FailureCategoryUnknown},
+		{"failed to pull image gcr.io/p/i", nil, batchpkg.FailureCategoryImagePull},
+		{"Task failed due to Spot VM preemption with exit code 50001.", code(50001), batchpkg.FailureCategoryPreempted},
+		{"Task exceeded max run duration", code(50005), batchpkg.FailureCategoryTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got := classifyFailure(tt.exitCode, []batchpkg.StatusEvent{{Description: tt.description}})
+			if got != tt.want {
+				t.Errorf("classifyFailure = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	batch "cloud.google.com/go/batch/apiv1"
+	"cloud.google.com/go/batch/apiv1/batchpb"
+	logging "google.golang.org/api/logging/v2"
+
+	batchpkg "github.com/alphauslabs/jennah/internal/batch"
+)
+
+func init() {
+	// Register GCP log source constructor
+	batchpkg.RegisterGCPLogSource(NewCloudLoggingSource)
+}
+
+const (
+	// batchTaskLogName is the log GCP Batch writes task output to when
+	// LogsPolicy is CLOUD_LOGGING.
+	batchTaskLogName = "batch_task_logs"
+
+	defaultLogPageSize = 500
+)
+
+// CloudLoggingSource implements batch.LogSource by reading the task logs GCP
+// Batch writes to Cloud Logging.
+type CloudLoggingSource struct {
+	batchClient *batch.Client
+	logging     *logging.Service
+	projectID   string
+
+	// jobUIDs caches the UIDs looked up for requests that did not carry one,
+	// keyed by resource path. A job's UID never changes.
+	jobUIDs sync.Map
+}
+
+// NewCloudLoggingSource creates a log source backed by Cloud Logging.
+// config.ProjectID is used for jobs whose resource path does not name a project.
+func NewCloudLoggingSource(ctx context.Context, config batchpkg.ProviderConfig) (batchpkg.LogSource, error) {
+	batchClient, err := batch.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCP Batch client: %w", err)
+	}
+
+	service, err := logging.NewService(ctx)
+	if err != nil {
+		batchClient.Close()
+		return nil, fmt.Errorf("failed to create Cloud Logging client: %w", err)
+	}
+
+	return &CloudLoggingSource{
+		batchClient: batchClient,
+		logging:     service,
+		projectID:   config.ProjectID,
+	}, nil
+}
+
+// ReadLogs returns one page of the job's task logs, oldest first.
+func (s *CloudLoggingSource) ReadLogs(ctx context.Context, req batchpkg.LogRequest) (*batchpkg.LogPage, error) {
+	projectID := projectFromResourcePath(req.CloudResourcePath)
+	if projectID == "" {
+		projectID = s.projectID
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("cannot determine project of job %s", req.CloudResourcePath)
+	}
+
+	// Batch labels task logs with the job's UID, not its name.
+	jobUID := req.ProviderJobUID
+	if jobUID == "" {
+		var err error
+		jobUID, err = s.lookupJobUID(ctx, req.CloudResourcePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filter := fmt.Sprintf(`logName="projects/%s/logs/%s" AND labels.job_uid="%s"`, projectID, batchTaskLogName, jobUID)
+	if !req.Since.IsZero() {
+		filter += fmt.Sprintf(` AND timestamp>="%s"`, req.Since.UTC().Format(time.RFC3339Nano))
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultLogPageSize
+	}
+
+	resp, err := s.logging.Entries.List(&logging.ListLogEntriesRequest{
+		ResourceNames: []string{"projects/" + projectID},
+		Filter:        filter,
+		OrderBy:       "timestamp asc",
+		PageSize:      int64(pageSize),
+		PageToken:     req.PageToken,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list log entries: %w", err)
+	}
+
+	page := &batchpkg.LogPage{NextPageToken: resp.NextPageToken}
+	for _, entry := range resp.Entries {
+		timestamp, _ := time.Parse(time.RFC3339Nano, entry.Timestamp)
+		page.Entries = append(page.Entries, batchpkg.LogEntry{
+			ID:        entry.InsertId,
+			Timestamp: timestamp,
+			Severity:  entry.Severity,
+			Message:   entryMessage(entry),
+		})
+	}
+	return page, nil
+}
+
+// lookupJobUID returns the UID of a job submitted before UIDs were stored,
+// asking GCP Batch only the first time. This fails once the job has been
+// deleted from Batch.
+func (s *CloudLoggingSource) lookupJobUID(ctx context.Context, path string) (string, error) {
+	if uid, ok := s.jobUIDs.Load(path); ok {
+		return uid.(string), nil
+	}
+	job, err := s.batchClient.GetJob(ctx, &batchpb.GetJobRequest{Name: path})
+	if err != nil {
+		return "", fmt.Errorf("failed to get GCP Batch job: %w", err)
+	}
+	s.jobUIDs.Store(path, job.Uid)
+	return job.Uid, nil
+}
+
+// projectFromResourcePath returns P from "projects/P/locations/L/jobs/J".
+func projectFromResourcePath(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) >= 2 && parts[0] == "projects" {
+		return parts[1]
+	}
+	return ""
+}
+
+// entryMessage returns the text of a log entry. Structured entries use their
+// "message" field when present, falling back to the raw JSON.
+func entryMessage(entry *logging.LogEntry) string {
+	if entry.TextPayload != "" {
+		return entry.TextPayload
+	}
+	if len(entry.JsonPayload) == 0 {
+		return ""
+	}
+	var payload struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(entry.JsonPayload, &payload); err == nil && payload.Message != "" {
+		return payload.Message
+	}
+	return string(entry.JsonPayload)
+}
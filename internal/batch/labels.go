@@ -0,0 +1,62 @@
+package batch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// System labels set on every provider job, so cloud billing exports can be
+// attributed to a tenant and job.
+const (
+	LabelTenantID = "jennah-tenant-id"
+	LabelJobID    = "jennah-job-id"
+)
+
+// ReservedLabelPrefix starts every system label. User labels may not use it.
+const ReservedLabelPrefix = "jennah-"
+
+// MaxUserLabels is the provider limit of 64 labels less the system labels.
+const MaxUserLabels = 62
+
+var (
+	labelKeyPattern   = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+	labelValuePattern = regexp.MustCompile(`^[a-z0-9_-]{0,63}$`)
+)
+
+// ValidateLabelKey checks a label key against cloud label rules: 1-63
+// lowercase letters, digits, underscores and dashes, starting with a letter.
+func ValidateLabelKey(key string) error {
+	if !labelKeyPattern.MatchString(key) {
+		return fmt.Errorf("label key %q must be 1-63 lowercase letters, digits, _ or -, starting with a letter", key)
+	}
+	return nil
+}
+
+// ValidateLabelValue checks a label value against cloud label rules: at most
+// 63 lowercase letters, digits, underscores and dashes.
+func ValidateLabelValue(key, value string) error {
+	if !labelValuePattern.MatchString(value) {
+		return fmt.Errorf("label %q value %q must be at most 63 lowercase letters, digits, _ or -", key, value)
+	}
+	return nil
+}
+
+// ValidateUserLabels checks labels submitted with a job.
+func ValidateUserLabels(labels map[string]string) error {
+	if len(labels) > MaxUserLabels {
+		return fmt.Errorf("at most %d labels are allowed", MaxUserLabels)
+	}
+	for key, value := range labels {
+		if err := ValidateLabelKey(key); err != nil {
+			return err
+		}
+		if strings.HasPrefix(key, ReservedLabelPrefix) {
+			return fmt.Errorf("label key %q uses the reserved %q prefix", key, ReservedLabelPrefix)
+		}
+		if err := ValidateLabelValue(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LogSource reads the output of batch jobs. Like Provider it hides where the
+// logs live (GCP: Cloud Logging) so callers can page and follow job output
+// without knowing the cloud.
+type LogSource interface {
+	// ReadLogs returns one page of a job's log entries, oldest first.
+	ReadLogs(ctx context.Context, req LogRequest) (*LogPage, error)
+}
+
+// LogRequest selects the log entries of one provider job.
+type LogRequest struct {
+	// CloudResourcePath identifies the job, as returned in JobResult.
+	CloudResourcePath string
+
+	// ProviderJobUID is the job's UID from JobResult (optional). Sources that
+	// need it look it up from CloudResourcePath when it is empty.
+	ProviderJobUID string
+
+	// Since limits results to entries at or after this time (optional).
+	Since time.Time
+
+	// PageSize is the maximum number of entries to return. 0 means the source's default.
+	PageSize int
+
+	// PageToken continues from a previous page's NextPageToken.
+	PageToken string
+}
+
+// LogPage is one page of log entries.
+type LogPage struct {
+	Entries []LogEntry
+
+	// NextPageToken is set when more entries matched than fit on the page.
+	NextPageToken string
+}
+
+// LogEntry is one line of job output.
+type LogEntry struct {
+	// ID is unique among the job's entries and stable across reads, so
+	// callers that re-read from a timestamp can skip entries already seen.
+	ID        string
+	Timestamp time.Time
+	// Severity is the provider's level (e.g. "INFO", "ERROR"), if any.
+	Severity string
+	Message  string
+}
+
+// NewLogSource creates a log source for the provider named in config.
+func NewLogSource(ctx context.Context, config ProviderConfig) (LogSource, error) {
+	switch config.Provider {
+	case "gcp":
+		if newGCPLogSource != nil {
+			return newGCPLogSource(ctx, config)
+		}
+	case "fake":
+		if newFakeLogSource != nil {
+			return newFakeLogSource(ctx, config)
+		}
+	}
+	return nil, fmt.Errorf("unsupported log source: %s", config.Provider)
+}
+
+// Log source constructors (implemented in the provider packages)
+var (
+	newGCPLogSource  func(context.Context, ProviderConfig) (LogSource, error)
+	newFakeLogSource func(context.Context, ProviderConfig) (LogSource, error)
+)
+
+// RegisterGCPLogSource registers the Cloud Logging log source constructor.
+func RegisterGCPLogSource(fn func(context.Context, ProviderConfig) (LogSource, error)) {
+	newGCPLogSource = fn
+}
+
+// RegisterFakeLogSource registers the file-backed fake log source constructor.
+func RegisterFakeLogSource(fn func(context.Context, ProviderConfig) (LogSource, error)) {
+	newFakeLogSource = fn
+}
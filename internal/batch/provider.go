@@ -3,6 +3,7 @@ package batch
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // Provider defines the interface for cloud batch service implementations.
@@ -13,12 +14,16 @@ type Provider interface {
 	// Returns the internal job ID and cloud resource path (e.g., GCP: projects/.../jobs/..., AWS: ARN).
 	SubmitJob(ctx context.Context, config JobConfig) (*JobResult, error)
 
-	// GetJobStatus retrieves the current status of a job.
-	GetJobStatus(ctx context.Context, cloudResourcePath string) (JobStatus, error)
+	// GetJobStatus retrieves the current status of a job, plus its exit code
+	// once it has finished.
+	GetJobStatus(ctx context.Context, cloudResourcePath string) (*JobStatusInfo, error)
 
 	// CancelJob cancels a running job.
 	CancelJob(ctx context.Context, cloudResourcePath string) error
 
+	// ListTasks returns the status of each task of a job, ordered by index.
+	ListTasks(ctx context.Context, cloudResourcePath string) ([]TaskStatusInfo, error)
+
 	// ListJobs lists all jobs for the configured project/account.
 	// Returns cloud resource paths.
 	ListJobs(ctx context.Context) ([]string, error)
@@ -31,13 +36,194 @@ type JobConfig struct {
 	JobID string
 
 	// ImageURI is the container image to run (e.g., gcr.io/project/image:tag).
+	// Empty when Script is set.
 	ImageURI string
 
+	// Script runs inline text on the host instead of a container (optional).
+	// Commands, Entrypoint, WorkingDir and User apply to containers only.
+	Script *Script
+
 	// EnvVars are environment variables to pass to the container.
 	EnvVars map[string]string
 
+	// SecretEnvVars are environment variables the provider fills from
+	// secrets when the job starts, keyed by name. Values are provider
+	// references from a secrets.Resolver, e.g. Secret Manager version
+	// resource names on GCP, never the secret values themselves.
+	SecretEnvVars map[string]string
+
+	// Commands replace the image's CMD (optional).
+	Commands []string
+
+	// Entrypoint replaces the image's ENTRYPOINT (optional).
+	Entrypoint string
+
+	// WorkingDir is the directory the command runs in (optional).
+	WorkingDir string
+
+	// User runs the container as a name, uid or uid:gid (optional).
+	User string
+
+	// TaskCount is how many tasks the job fans out into. Each task runs the
+	// same runnables and sees its index in BATCH_TASK_INDEX. 0 means 1.
+	TaskCount int64
+
+	// Parallelism caps how many tasks run at once. 0 leaves it to the provider.
+	Parallelism int64
+
+	// Steps run in order within each task (optional). When set, they replace
+	// ImageURI, Script, Commands, Entrypoint, WorkingDir and User, and EnvVars
+	// apply to every step.
+	Steps []Step
+
+	// Volumes are mounted into every runnable of every task (optional).
+	Volumes []Volume
+
+	// Labels are set on the provider job, including the system labels
+	// LabelTenantID and LabelJobID (optional).
+	Labels map[string]string
+
+	// Priority orders the job against the project's other queued jobs,
+	// 0 (default) to 99 (highest).
+	Priority int64
+
+	// Allocation chooses the VMs the job runs on (optional). Nil leaves it to
+	// the provider.
+	Allocation *AllocationPolicy
+
 	// Resources specifies compute resource requirements (optional).
 	Resources *ResourceRequirements
+
+	// LifecyclePolicies decide, by container exit code, whether the provider
+	// retries or fails a failed task (optional).
+	LifecyclePolicies []LifecyclePolicy
+
+	// MaxTaskRetries is how many times the provider may retry a failed task
+	// within one job. 0 means never.
+	MaxTaskRetries int32
+}
+
+// Step is one runnable of a multi-step job. Exactly one of ImageURI, Script
+// and Barrier is set.
+type Step struct {
+	// Name labels the step in provider logs and names a barrier (optional).
+	Name string
+
+	// ImageURI, Commands, Entrypoint, WorkingDir and User describe a container step.
+	ImageURI   string
+	Commands   []string
+	Entrypoint string
+	WorkingDir string
+	User       string
+
+	// Script makes this a script step.
+	Script *Script
+
+	// Barrier makes this step wait until every task of the job reaches it.
+	Barrier bool
+
+	// EnvVars are added to the job's env vars for this step only.
+	EnvVars map[string]string
+
+	// Background starts the step and moves on without waiting for it. The
+	// provider stops it once the foreground steps finish.
+	Background bool
+
+	// IgnoreExitStatus lets the job continue when the step fails.
+	IgnoreExitStatus bool
+}
+
+// Script is an inline runnable.
+type Script struct {
+	// Text is the script body.
+	Text string
+
+	// Interpreter runs Text, e.g. "/bin/bash" (optional). Empty leaves the
+	// choice to the script's own "#!" line or the provider default.
+	Interpreter string
+}
+
+// AllocationPolicy chooses the VMs a job runs on. Empty fields are left to
+// the provider.
+type AllocationPolicy struct {
+	// MachineType is a machine type, e.g. "n2-standard-4", or a machine
+	// family, e.g. "n2".
+	MachineType string
+
+	ProvisioningModel ProvisioningModel
+
+	// Zones limits the job to these zones of the provider's region.
+	Zones []string
+}
+
+// ProvisioningModel is how the job's VMs are bought.
+type ProvisioningModel string
+
+const (
+	// ProvisioningModelStandard uses regular on-demand VMs.
+	ProvisioningModelStandard ProvisioningModel = "STANDARD"
+
+	// ProvisioningModelSpot uses discounted spot VMs, which the provider may
+	// reclaim at any time.
+	ProvisioningModelSpot ProvisioningModel = "SPOT"
+)
+
+// Volume is storage mounted at MountPath. Exactly one of GCS, NFS and Disk
+// is set.
+type Volume struct {
+	// MountPath is the absolute path the volume is mounted at.
+	MountPath string
+
+	// ReadOnly mounts a GCS or NFS volume read-only.
+	ReadOnly bool
+
+	GCS  *GCSVolume
+	NFS  *NFSVolume
+	Disk *DiskVolume
+}
+
+// GCSVolume is a Cloud Storage bucket, or a folder within one.
+type GCSVolume struct {
+	Bucket string
+
+	// Path is a folder within Bucket (optional).
+	Path string
+}
+
+// NFSVolume is a directory exported by an NFS server.
+type NFSVolume struct {
+	Server     string
+	RemotePath string
+}
+
+// DiskVolume is an empty disk created for each task and deleted with it.
+type DiskVolume struct {
+	SizeGB int64
+
+	// Type is the provider's disk type, e.g. "pd-balanced" (optional).
+	Type string
+}
+
+// LifecycleAction is what the provider does with a failed task whose exit
+// code matches a LifecyclePolicy.
+type LifecycleAction string
+
+const (
+	// LifecycleActionRetryTask retries matching tasks (up to MaxTaskRetries)
+	// and fails tasks with any other exit code.
+	// Maps to: GCP LifecyclePolicy RETRY_TASK.
+	LifecycleActionRetryTask LifecycleAction = "RETRY_TASK"
+
+	// LifecycleActionFailTask fails matching tasks immediately and retries
+	// tasks with any other exit code.
+	// Maps to: GCP LifecyclePolicy FAIL_TASK.
+	LifecycleActionFailTask LifecycleAction = "FAIL_TASK"
+)
+
+// LifecyclePolicy maps container exit codes to a LifecycleAction.
+type LifecyclePolicy struct {
+	Action    LifecycleAction
+	ExitCodes []int32
 }
 
 // ResourceRequirements specifies compute resource requirements for a job.
@@ -61,10 +247,76 @@ type JobResult struct {
 	//   - Azure: "/subscriptions/.../resourceGroups/.../providers/Microsoft.Batch/..."
 	CloudResourcePath string
 
+	// ProviderJobUID is the provider's unique ID of the job, when it has one
+	// apart from the resource path (GCP: the UID that labels its task logs).
+	// Optional.
+	ProviderJobUID string
+
 	// InitialStatus is the job status immediately after submission.
 	InitialStatus JobStatus
 }
 
+// JobStatusInfo is a job's status as reported by the provider.
+type JobStatusInfo struct {
+	Status JobStatus
+
+	// ExitCode is the container exit code of the job's last task execution.
+	// It is nil until the provider reports one, normally once the job finishes.
+	ExitCode *int32
+
+	// FailureCategory says why a FAILED job failed. Empty for other statuses.
+	FailureCategory FailureCategory
+
+	// Events are the provider's most recent status events, oldest first.
+	Events []StatusEvent
+}
+
+// TaskStatusInfo is the status of one task of a job.
+type TaskStatusInfo struct {
+	// Index is the task's BATCH_TASK_INDEX, starting at 0.
+	Index int64
+
+	// Status uses the job statuses. A task that never ran because the job
+	// ended first is CANCELLED.
+	Status JobStatus
+
+	// ExitCode is the exit code of the task's last execution, if it ran.
+	ExitCode *int32
+
+	// Message is the task's most recent status event, if any.
+	Message string
+}
+
+// StatusEvent is one provider-reported event in a job's life.
+type StatusEvent struct {
+	Time        time.Time
+	Type        string
+	Description string
+}
+
+// FailureCategory is a provider-neutral classification of a job failure.
+type FailureCategory string
+
+const (
+	// FailureCategoryImagePull means the container image could not be pulled.
+	FailureCategoryImagePull FailureCategory = "IMAGE_PULL"
+
+	// FailureCategoryOutOfMemory means the container was killed for exceeding its memory.
+	FailureCategoryOutOfMemory FailureCategory = "OUT_OF_MEMORY"
+
+	// FailureCategoryTimeout means the job ran past its maximum run duration.
+	FailureCategoryTimeout FailureCategory = "TIMEOUT"
+
+	// FailureCategoryPreempted means the provider reclaimed the job's VM.
+	FailureCategoryPreempted FailureCategory = "PREEMPTED"
+
+	// FailureCategoryUserError means the container exited with a non-zero code of its own.
+	FailureCategoryUserError FailureCategory = "USER_ERROR"
+
+	// FailureCategoryUnknown means the provider gave nothing to classify the failure by.
+	FailureCategoryUnknown FailureCategory = "UNKNOWN"
+)
+
 // JobStatus represents the status of a batch job.
 // This enum maps various cloud provider states to a common set.
 type JobStatus string
@@ -100,7 +352,7 @@ const (
 
 // ProviderConfig contains configuration for initializing a batch provider.
 type ProviderConfig struct {
-	// Provider is the cloud provider name ("gcp", "aws", "azure").
+	// Provider is the cloud provider name ("gcp", "aws", "azure", "fake").
 	Provider string
 
 	// Region is the cloud region for batch operations.
@@ -126,6 +378,8 @@ func NewProvider(ctx context.Context, config ProviderConfig) (Provider, error) {
 		return newAWSProvider(ctx, config)
 	case "azure":
 		return newAzureProvider(ctx, config)
+	case "fake":
+		return newFakeProvider(ctx, config)
 	default:
 		return nil, fmt.Errorf("unsupported batch provider: %s", config.Provider)
 	}
@@ -136,6 +390,7 @@ var (
 	newGCPProvider   func(context.Context, ProviderConfig) (Provider, error)
 	newAWSProvider   func(context.Context, ProviderConfig) (Provider, error)
 	newAzureProvider func(context.Context, ProviderConfig) (Provider, error)
+	newFakeProvider  func(context.Context, ProviderConfig) (Provider, error)
 )
 
 // RegisterGCPProvider registers the GCP batch provider constructor.
@@ -152,3 +407,8 @@ func RegisterAWSProvider(fn func(context.Context, ProviderConfig) (Provider, err
 func RegisterAzureProvider(fn func(context.Context, ProviderConfig) (Provider, error)) {
 	newAzureProvider = fn
 }
+
+// RegisterFakeProvider registers the in-memory fake batch provider constructor.
+func RegisterFakeProvider(fn func(context.Context, ProviderConfig) (Provider, error)) {
+	newFakeProvider = fn
+}
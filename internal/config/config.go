@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/alphauslabs/jennah/internal/batch"
+	"github.com/alphauslabs/jennah/internal/envelope"
+	"github.com/alphauslabs/jennah/internal/secrets"
 )
 
 // Config represents the complete worker configuration.
@@ -18,6 +21,30 @@ type Config struct {
 
 	// Database configuration.
 	Database DatabaseConfig
+
+	// Reconciler configuration for the background job status sync.
+	Reconciler ReconcilerConfig
+
+	// Secrets configures how secret env var references are resolved.
+	Secrets secrets.Config
+
+	// Encryption configures the key-encryption key for stored env vars.
+	Encryption envelope.Config
+
+	// RequestIDTTL is how long a SubmitJob request ID is remembered for
+	// idempotent retries.
+	RequestIDTTL time.Duration
+}
+
+// ReconcilerConfig controls how often the worker polls the batch provider
+// for the status of non-terminal jobs.
+type ReconcilerConfig struct {
+	// Interval is the base delay between reconciliation passes.
+	// Each pass is jittered by up to ±20% to avoid workers polling in lockstep.
+	Interval time.Duration
+
+	// Concurrency is the maximum number of jobs polled in parallel.
+	Concurrency int
 }
 
 // DatabaseConfig contains database connection configuration.
@@ -56,6 +83,27 @@ func LoadFromEnv() (*Config, error) {
 			Database:        os.Getenv("DB_DATABASE"),
 			ProviderOptions: make(map[string]string),
 		},
+		Reconciler: ReconcilerConfig{
+			Interval:    time.Duration(getEnvAsInt("RECONCILE_INTERVAL_SECONDS", 30)) * time.Second,
+			Concurrency: getEnvAsInt("RECONCILE_CONCURRENCY", 8),
+		},
+		Secrets: secrets.Config{
+			Provider:  os.Getenv("SECRET_PROVIDER"),
+			ProjectID: getEnvOrDefault("SECRETS_PROJECT_ID", os.Getenv("BATCH_PROJECT_ID")),
+			Dir:       os.Getenv("SECRETS_DIR"),
+		},
+		Encryption: envelope.Config{
+			Provider: os.Getenv("ENV_KEK_PROVIDER"),
+			KeyFile:  os.Getenv("ENV_KEK_FILE"),
+		},
+		RequestIDTTL: time.Duration(getEnvAsInt("REQUEST_ID_TTL_HOURS", 24)) * time.Hour,
+	}
+
+	// GCP workers resolve secrets through Secret Manager unless told otherwise
+	if config.Secrets.Provider == "" && config.BatchProvider.Provider == "gcp" {
+		config.Secrets.Provider = "gcp"
+	} else if config.Secrets.Provider == "none" {
+		config.Secrets.Provider = ""
 	}
 
 	// Load provider-specific batch options
@@ -71,6 +119,9 @@ func LoadFromEnv() (*Config, error) {
 	if azureResourceGroup := os.Getenv("AZURE_RESOURCE_GROUP"); azureResourceGroup != "" {
 		config.BatchProvider.ProviderOptions["resource_group"] = azureResourceGroup
 	}
+	if fakeOutcome := os.Getenv("FAKE_BATCH_OUTCOME"); fakeOutcome != "" {
+		config.BatchProvider.ProviderOptions["outcome"] = fakeOutcome
+	}
 
 	// Load provider-specific database options
 	if dbEndpoint := os.Getenv("DB_ENDPOINT"); dbEndpoint != "" {
@@ -113,10 +164,50 @@ func (c *Config) Validate() error {
 		if c.BatchProvider.ProviderOptions["subscription_id"] == "" {
 			return fmt.Errorf("AZURE_SUBSCRIPTION_ID is required for Azure batch provider")
 		}
+	case "fake":
+		// In-memory provider for offline development; nothing to validate.
 	default:
 		return fmt.Errorf("unsupported batch provider: %s", c.BatchProvider.Provider)
 	}
 
+	if c.Reconciler.Interval <= 0 {
+		return fmt.Errorf("RECONCILE_INTERVAL_SECONDS must be positive")
+	}
+	if c.Reconciler.Concurrency <= 0 {
+		return fmt.Errorf("RECONCILE_CONCURRENCY must be positive")
+	}
+	if c.RequestIDTTL <= 0 {
+		return fmt.Errorf("REQUEST_ID_TTL_HOURS must be positive")
+	}
+
+	// Validate secret resolver configuration
+	switch c.Secrets.Provider {
+	case "":
+		// Secret env vars are rejected.
+	case "gcp":
+		if c.Secrets.ProjectID == "" {
+			return fmt.Errorf("SECRETS_PROJECT_ID or BATCH_PROJECT_ID is required for the gcp secret provider")
+		}
+	case "file":
+		if c.Secrets.Dir == "" {
+			return fmt.Errorf("SECRETS_DIR is required for the file secret provider")
+		}
+	default:
+		return fmt.Errorf("unsupported secret provider: %s", c.Secrets.Provider)
+	}
+
+	// Validate env var encryption configuration
+	switch c.Encryption.Provider {
+	case "":
+		// Env vars are stored in plain text.
+	case "keyfile":
+		if c.Encryption.KeyFile == "" {
+			return fmt.Errorf("ENV_KEK_FILE is required for the keyfile KEK provider")
+		}
+	default:
+		return fmt.Errorf("unsupported KEK provider: %s", c.Encryption.Provider)
+	}
+
 	// Validate database configuration
 	switch c.Database.Provider {
 	case "spanner":
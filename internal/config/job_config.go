@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/alphauslabs/jennah/internal/batch"
 )
@@ -14,11 +17,18 @@ type JobConfigFile struct {
 	ResourceProfiles  map[string]ResourceProfile `json:"resourceProfiles"`
 }
 
-// ResourceProfile defines resource requirements for a job.
+// ResourceProfile defines resource requirements for a job, and optionally
+// where and how urgently it runs.
 type ResourceProfile struct {
 	CPUMillis             int64 `json:"cpuMillis"`
 	MemoryMiB             int64 `json:"memoryMiB"`
 	MaxRunDurationSeconds int64 `json:"maxRunDurationSeconds"`
+
+	// Scheduling defaults, overridable per job.
+	Priority          int64    `json:"priority,omitempty"`
+	MachineType       string   `json:"machineType,omitempty"`
+	ProvisioningModel string   `json:"provisioningModel,omitempty"`
+	Zones             []string `json:"zones,omitempty"`
 }
 
 // LoadJobConfig loads job configuration from a JSON file.
@@ -33,23 +43,71 @@ func LoadJobConfig(filePath string) (*JobConfigFile, error) {
 		return nil, fmt.Errorf("failed to parse config JSON: %w", err)
 	}
 
+	if err := config.DefaultResources.validateScheduling(); err != nil {
+		return nil, fmt.Errorf("defaultResources: %w", err)
+	}
+	names := make([]string, 0, len(config.ResourceProfiles))
+	for name := range config.ResourceProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		profile := config.ResourceProfiles[name]
+		if err := profile.validateScheduling(); err != nil {
+			return nil, fmt.Errorf("resource profile %q: %w", name, err)
+		}
+		config.ResourceProfiles[name] = profile
+	}
+
 	return &config, nil
 }
 
-// GetResourceRequirements returns resource requirements for a profile name.
-// If profileName is empty or not found, returns default resources.
-func (c *JobConfigFile) GetResourceRequirements(profileName string) *batch.ResourceRequirements {
-	var profile ResourceProfile
+// MaxPriority is the highest job priority the provider accepts.
+const MaxPriority = 99
 
-	if profileName != "" {
-		if p, exists := c.ResourceProfiles[profileName]; exists {
-			profile = p
-		} else {
-			profile = c.DefaultResources
+var (
+	// MachineTypePattern matches machine types ("n2-standard-4") and families ("n2").
+	MachineTypePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{0,62}$`)
+	// ZonePattern matches zone names such as "asia-northeast1-a".
+	ZonePattern = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+-[a-z]$`)
+)
+
+// validateScheduling checks a profile's scheduling defaults with the rules
+// applied to job submissions, upper-casing the provisioning model.
+func (p *ResourceProfile) validateScheduling() error {
+	if p.Priority < 0 || p.Priority > MaxPriority {
+		return fmt.Errorf("priority must be between 0 and %d", MaxPriority)
+	}
+	if p.MachineType != "" && !MachineTypePattern.MatchString(p.MachineType) {
+		return fmt.Errorf(`machineType %q must be a machine type such as "n2-standard-4" or a family such as "n2"`, p.MachineType)
+	}
+	switch model := strings.ToUpper(p.ProvisioningModel); batch.ProvisioningModel(model) {
+	case "", batch.ProvisioningModelStandard, batch.ProvisioningModelSpot:
+		p.ProvisioningModel = model
+	default:
+		return fmt.Errorf("provisioningModel %q must be %s or %s", p.ProvisioningModel, batch.ProvisioningModelStandard, batch.ProvisioningModelSpot)
+	}
+	for _, zone := range p.Zones {
+		if !ZonePattern.MatchString(zone) {
+			return fmt.Errorf("zones: %q is not a zone name such as asia-northeast1-a", zone)
 		}
-	} else {
-		profile = c.DefaultResources
 	}
+	return nil
+}
+
+// profile returns the named profile, or the defaults if profileName is empty
+// or not found.
+func (c *JobConfigFile) profile(profileName string) ResourceProfile {
+	if p, exists := c.ResourceProfiles[profileName]; exists && profileName != "" {
+		return p
+	}
+	return c.DefaultResources
+}
+
+// GetResourceRequirements returns resource requirements for a profile name.
+// If profileName is empty or not found, returns default resources.
+func (c *JobConfigFile) GetResourceRequirements(profileName string) *batch.ResourceRequirements {
+	profile := c.profile(profileName)
 
 	return &batch.ResourceRequirements{
 		CPUMillis:             profile.CPUMillis,
@@ -91,3 +149,40 @@ func (c *JobConfigFile) ResolveResources(profileName string, override *ResourceO
 
 	return base
 }
+
+// Scheduling holds a job's priority and VM choices. Zero values mean "not
+// set".
+type Scheduling struct {
+	Priority          int64
+	MachineType       string
+	ProvisioningModel string
+	Zones             []string
+}
+
+// ResolveScheduling returns the effective scheduling of a job by merging a
+// named preset with the values set on the job, which take precedence field
+// by field.
+func (c *JobConfigFile) ResolveScheduling(profileName string, override Scheduling) Scheduling {
+	profile := c.profile(profileName)
+	result := Scheduling{
+		Priority:          profile.Priority,
+		MachineType:       profile.MachineType,
+		ProvisioningModel: profile.ProvisioningModel,
+		Zones:             profile.Zones,
+	}
+
+	if override.Priority != 0 {
+		result.Priority = override.Priority
+	}
+	if override.MachineType != "" {
+		result.MachineType = override.MachineType
+	}
+	if override.ProvisioningModel != "" {
+		result.ProvisioningModel = override.ProvisioningModel
+	}
+	if len(override.Zones) > 0 {
+		result.Zones = override.Zones
+	}
+
+	return result
+}
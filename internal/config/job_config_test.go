@@ -0,0 +1,64 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeJobConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "job-config.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadJobConfigNormalizesScheduling(t *testing.T) {
+	config, err := LoadJobConfig(writeJobConfig(t, `{
+		"defaultResources": {"cpuMillis": 1000, "memoryMiB": 512},
+		"resourceProfiles": {
+			"batch": {"cpuMillis": 2000, "memoryMiB": 4096, "priority": 10, "machineType": "n2", "provisioningModel": "spot", "zones": ["asia-northeast1-a"]}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("LoadJobConfig: %v", err)
+	}
+	if got := config.ResolveScheduling("batch", Scheduling{}).ProvisioningModel; got != "SPOT" {
+		t.Errorf("provisioning model = %q, want SPOT", got)
+	}
+}
+
+func TestLoadJobConfigRejectsBadScheduling(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+		wantErr string
+	}{
+		{"provisioning model", `{"provisioningModel": "spt"}`, "provisioningModel"},
+		{"priority", `{"priority": 100}`, "priority"},
+		{"negative priority", `{"priority": -1}`, "priority"},
+		{"machine type", `{"machineType": "N2 Standard"}`, "machineType"},
+		{"zone", `{"zones": ["asia-northeast1"]}`, "zones"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadJobConfig(writeJobConfig(t, `{"resourceProfiles": {"bad": `+tt.profile+`}}`))
+			if err == nil || !strings.Contains(err.Error(), `"bad"`) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadJobConfig error = %v, want one about %s of profile \"bad\"", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := LoadJobConfig(writeJobConfig(t, `{"defaultResources": {"provisioningModel": "spt"}}`)); err == nil || !strings.Contains(err.Error(), "defaultResources") {
+		t.Errorf("LoadJobConfig error = %v, want one about defaultResources", err)
+	}
+}
+
+func TestLoadJobConfigRepositoryDefault(t *testing.T) {
+	if _, err := LoadJobConfig(filepath.Join("..", "..", "config", "job-config.json")); err != nil {
+		t.Fatalf("LoadJobConfig(config/job-config.json): %v", err)
+	}
+}
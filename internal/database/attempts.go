@@ -0,0 +1,249 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// ErrRetriesExhausted is returned by RetryJob when the job has already used
+// all of its retries.
+var ErrRetriesExhausted = errors.New("job has no retries left")
+
+// ErrPreemptionsExhausted is returned by PreemptJob when the job has already
+// been resubmitted after preemption as often as allowed.
+var ErrPreemptionsExhausted = errors.New("job has no preemption retries left")
+
+// attemptMutation upserts the status of one JobAttempts row, setting EndedAt
+// once the attempt reaches a terminal status.
+func attemptMutation(tenantID, jobID string, attemptNumber int64, status string, columns []string, values []interface{}) *spanner.Mutation {
+	cols := append([]string{"TenantId", "JobId", "AttemptNumber", "Status"}, columns...)
+	vals := append([]interface{}{tenantID, jobID, attemptNumber, status}, values...)
+	if IsTerminalStatus(status) || status == AttemptStatusPreempted {
+		cols = append(cols, "EndedAt")
+		vals = append(vals, spanner.CommitTimestamp)
+	}
+	return spanner.InsertOrUpdate("JobAttempts", cols, vals)
+}
+
+// RecordAttemptSubmitted stores the provider job for the job's current attempt
+// and moves the job to the provider's initial status. providerJobUID may be
+// empty if the provider has none. The attempt's StartedAt is set and any
+// pending retry time is cleared.
+func (c *Client) RecordAttemptSubmitted(ctx context.Context, tenantID, jobID, status, providerJobID, providerJobUID, cloudResourcePath string) error {
+	uid := spanner.NullString{StringVal: providerJobUID, Valid: providerJobUID != ""}
+	err := c.transitionJob(ctx, tenantID, jobID, statusChange{
+		toStatus:       status,
+		reason:         "batch job submitted",
+		columns:        []string{"CloudJobResourcePath", "NextAttemptAt"},
+		values:         []interface{}{cloudResourcePath, nil},
+		attemptColumns: []string{"ProviderJobId", "ProviderJobUid", "CloudJobResourcePath", "StartedAt"},
+		attemptValues:  []interface{}{providerJobID, uid, cloudResourcePath, spanner.CommitTimestamp},
+		allowUnchanged: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record submitted attempt: %w", err)
+	}
+	return nil
+}
+
+// RetryJob fails the job's current attempt and queues the next one in a
+// single transaction. Both the → FAILED and FAILED → PENDING transitions are
+// recorded, RetryCount is incremented, the provider path, outcome and
+// lifecycle timestamps are cleared, and NextAttemptAt is set for the
+// reconciler. outcome is kept on the failed attempt. Resubmissions after
+// preemption count towards RetryCount but not towards MaxRetries.
+func (c *Client) RetryJob(ctx context.Context, tenantID, jobID, failureClass, errorMessage string, outcome *JobOutcome, nextAttemptAt time.Time) error {
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, "Jobs", spanner.Key{tenantID, jobID}, []string{"Status", "RetryCount", "MaxRetries", "PreemptionCount"})
+		if err != nil {
+			return err
+		}
+		var fromStatus string
+		var retryCount, maxRetries, preemptionCount int64
+		if err := row.Columns(&fromStatus, &retryCount, &maxRetries, &preemptionCount); err != nil {
+			return err
+		}
+		if !CanTransition(fromStatus, JobStatusFailed) {
+			return fmt.Errorf("%w: %s → %s", ErrInvalidTransition, fromStatus, JobStatusFailed)
+		}
+		failureRetries := retryCount - preemptionCount
+		if failureRetries >= maxRetries {
+			return ErrRetriesExhausted
+		}
+
+		failed := JobStatusFailed
+		retryReason := fmt.Sprintf("retry %d of %d scheduled for %s", failureRetries+1, maxRetries, nextAttemptAt.UTC().Format(time.RFC3339))
+		columns := append([]string{"TenantId", "JobId", "Status", "RetryCount", "NextAttemptAt", "ErrorMessage",
+			"CloudJobResourcePath", "ScheduledAt", "StartedAt", "CompletedAt", "UpdatedAt"}, outcomeColumns...)
+		values := append([]interface{}{tenantID, jobID, JobStatusPending, retryCount + 1, nextAttemptAt, errorMessage,
+			nil, nil, nil, nil, spanner.CommitTimestamp}, (*JobOutcome)(nil).values()...)
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Update("Jobs", columns, values),
+			transitionMutation(tenantID, jobID, &fromStatus, JobStatusFailed, errorMessage),
+			transitionMutation(tenantID, jobID, &failed, JobStatusPending, retryReason),
+			attemptMutation(tenantID, jobID, retryCount+1, JobStatusFailed,
+				append([]string{"FailureClass", "ErrorMessage"}, outcomeColumns...),
+				append([]interface{}{failureClass, errorMessage}, outcome.values()...)),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to retry job: %w", err)
+	}
+	return nil
+}
+
+// PreemptJob ends the job's current attempt as PREEMPTED and queues the next
+// one in a single transaction, moving the job straight back to PENDING.
+// RetryCount and PreemptionCount are incremented and the rest is reset as in
+// RetryJob. It returns ErrPreemptionsExhausted once PreemptionCount has
+// reached maxPreemptions.
+func (c *Client) PreemptJob(ctx context.Context, tenantID, jobID, errorMessage string, outcome *JobOutcome, nextAttemptAt time.Time, maxPreemptions int64) error {
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, "Jobs", spanner.Key{tenantID, jobID}, []string{"Status", "RetryCount", "PreemptionCount"})
+		if err != nil {
+			return err
+		}
+		var fromStatus string
+		var retryCount, preemptionCount int64
+		if err := row.Columns(&fromStatus, &retryCount, &preemptionCount); err != nil {
+			return err
+		}
+		if !CanTransition(fromStatus, JobStatusPending) {
+			return fmt.Errorf("%w: %s → %s", ErrInvalidTransition, fromStatus, JobStatusPending)
+		}
+		if preemptionCount >= maxPreemptions {
+			return ErrPreemptionsExhausted
+		}
+
+		reason := fmt.Sprintf("%s; resubmission %d of %d after preemption scheduled for %s",
+			errorMessage, preemptionCount+1, maxPreemptions, nextAttemptAt.UTC().Format(time.RFC3339))
+		columns := append([]string{"TenantId", "JobId", "Status", "RetryCount", "PreemptionCount", "NextAttemptAt", "ErrorMessage",
+			"CloudJobResourcePath", "ScheduledAt", "StartedAt", "CompletedAt", "UpdatedAt"}, outcomeColumns...)
+		values := append([]interface{}{tenantID, jobID, JobStatusPending, retryCount + 1, preemptionCount + 1, nextAttemptAt, errorMessage,
+			nil, nil, nil, nil, spanner.CommitTimestamp}, (*JobOutcome)(nil).values()...)
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Update("Jobs", columns, values),
+			transitionMutation(tenantID, jobID, &fromStatus, JobStatusPending, reason),
+			attemptMutation(tenantID, jobID, retryCount+1, AttemptStatusPreempted,
+				append([]string{"FailureClass", "ErrorMessage"}, outcomeColumns...),
+				append([]interface{}{FailureClassRuntime, errorMessage}, outcome.values()...)),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resubmit preempted job: %w", err)
+	}
+	return nil
+}
+
+// ListRetriesDue returns PENDING jobs across all tenants whose next attempt
+// is due at or before now.
+func (c *Client) ListRetriesDue(ctx context.Context, now time.Time) ([]*Job, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + jobColumnList + `
+		      FROM Jobs@{FORCE_INDEX=JobsByNextAttemptAt}
+		      WHERE NextAttemptAt <= @now AND Status = @status`,
+		Params: map[string]interface{}{
+			"now":    now,
+			"status": JobStatusPending,
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var jobs []*Job
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate due retries: %w", err)
+		}
+
+		var job Job
+		if err := row.ToStruct(&job); err != nil {
+			return nil, fmt.Errorf("failed to parse job: %w", err)
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
+
+// ClaimRetry reserves a due retry for the caller by pushing NextAttemptAt
+// forward by lease. It reports false if the job is no longer due, for example
+// because another worker claimed it first. If the claimant dies before
+// submitting, the retry becomes due again once the lease expires.
+func (c *Client) ClaimRetry(ctx context.Context, tenantID, jobID string, lease time.Duration) (bool, error) {
+	claimed := false
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		claimed = false
+		row, err := txn.ReadRow(ctx, "Jobs", spanner.Key{tenantID, jobID}, []string{"Status", "NextAttemptAt"})
+		if err != nil {
+			return err
+		}
+		var status string
+		var nextAttemptAt spanner.NullTime
+		if err := row.Columns(&status, &nextAttemptAt); err != nil {
+			return err
+		}
+		now := time.Now()
+		if status != JobStatusPending || !nextAttemptAt.Valid || nextAttemptAt.Time.After(now) {
+			return nil
+		}
+		claimed = true
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Update("Jobs",
+				[]string{"TenantId", "JobId", "NextAttemptAt", "UpdatedAt"},
+				[]interface{}{tenantID, jobID, now.Add(lease), spanner.CommitTimestamp},
+			),
+		})
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim retry: %w", err)
+	}
+	return claimed, nil
+}
+
+// ListJobAttempts returns every attempt of a job, first attempt first.
+func (c *Client) ListJobAttempts(ctx context.Context, tenantID, jobID string) ([]*JobAttempt, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT TenantId, JobId, AttemptNumber, Status, ProviderJobId, ProviderJobUid, CloudJobResourcePath,
+		             FailureClass, ErrorMessage, StartedAt, EndedAt, ExitCode, FailureCategory, ProviderEvents
+		      FROM JobAttempts
+		      WHERE TenantId = @tenantId AND JobId = @jobId
+		      ORDER BY AttemptNumber ASC`,
+		Params: map[string]interface{}{
+			"tenantId": tenantID,
+			"jobId":    jobID,
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var attempts []*JobAttempt
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate attempts: %w", err)
+		}
+
+		var attempt JobAttempt
+		if err := row.ToStruct(&attempt); err != nil {
+			return nil, fmt.Errorf("failed to parse attempt: %w", err)
+		}
+		attempts = append(attempts, &attempt)
+	}
+
+	return attempts, nil
+}
@@ -3,23 +3,33 @@ package database
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/option"
+
+	"github.com/alphauslabs/jennah/internal/envelope"
 )
 
 // Client wraps the Cloud Spanner client
 type Client struct {
 	client *spanner.Client
+
+	// kek wraps tenant data keys; nil stores env vars in plain text.
+	kek envelope.KeyEncryptionKey
+	// dataKeys caches unwrapped tenant data keys by tenant ID.
+	dataKeys sync.Map
 }
 
 // NewClient creates a new database client
 // project: GCP project ID (e.g., "labs-169405")
 // instance: Spanner instance ID (e.g., "alphaus-dev")
 // database: Database name (e.g., "main")
-func NewClient(ctx context.Context, project, instance, database string) (*Client, error) {
+// opts are passed on to the Spanner client.
+func NewClient(ctx context.Context, project, instance, database string, opts ...option.ClientOption) (*Client, error) {
 	dbPath := fmt.Sprintf("projects/%s/instances/%s/databases/%s", project, instance, database)
 
-	client, err := spanner.NewClient(ctx, dbPath)
+	client, err := spanner.NewClient(ctx, dbPath, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create spanner client: %w", err)
 	}
@@ -27,6 +37,12 @@ func NewClient(ctx context.Context, project, instance, database string) (*Client
 	return &Client{client: client}, nil
 }
 
+// SetKeyEncryptionKey enables encryption of stored env var values, with
+// tenant data keys wrapped by kek. Call it before the client is used.
+func (c *Client) SetKeyEncryptionKey(kek envelope.KeyEncryptionKey) {
+	c.kek = kek
+}
+
 // Close closes the database client
 func (c *Client) Close() {
 	c.client.Close()
@@ -0,0 +1,110 @@
+// Package dbtest runs database.Client against spannertest, an in-memory fake
+// of Cloud Spanner, so packages built on the database can be tested offline.
+package dbtest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/spanner/apiv1/spannerpb"
+	"cloud.google.com/go/spanner/spannertest"
+	"cloud.google.com/go/spanner/spansql"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// jsonColumnPattern matches JSON column definitions in schema.sql.
+var jsonColumnPattern = regexp.MustCompile(`(?m)^(\s*)(\w+) JSON,`)
+
+// NewClient starts an in-memory Spanner with database/schema.sql applied and
+// returns a client for it, closed when the test ends.
+//
+// spannertest cannot store JSON values, so JSON columns are created as
+// STRING(MAX) and reported back to the client as JSON. JSON functions such as
+// JSON_VALUE are not available.
+func NewClient(t testing.TB) *database.Client {
+	t.Helper()
+
+	schema, err := os.ReadFile(schemaPath())
+	if err != nil {
+		t.Fatalf("failed to read schema: %v", err)
+	}
+	text := strings.ReplaceAll(string(schema), "\r", "")
+	jsonColumns := map[string]bool{}
+	for _, m := range jsonColumnPattern.FindAllStringSubmatch(text, -1) {
+		jsonColumns[m[2]] = true
+	}
+	text = jsonColumnPattern.ReplaceAllString(text, "${1}${2} STRING(MAX),")
+	ddl, err := spansql.ParseDDL("schema.sql", text)
+	if err != nil {
+		t.Fatalf("failed to parse schema: %v", err)
+	}
+
+	srv, err := spannertest.NewServer("localhost:0")
+	if err != nil {
+		t.Fatalf("failed to start spannertest: %v", err)
+	}
+	t.Cleanup(srv.Close)
+	srv.SetLogger(func(string, ...interface{}) {})
+	if err := srv.UpdateDDL(ddl); err != nil {
+		t.Fatalf("failed to apply schema: %v", err)
+	}
+
+	conn, err := grpc.NewClient(srv.Addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStreamInterceptor(jsonColumnsInterceptor(jsonColumns)))
+	if err != nil {
+		t.Fatalf("failed to dial spannertest: %v", err)
+	}
+	client, err := database.NewClient(context.Background(), "test", "test", "test", option.WithGRPCConn(conn))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(client.Close)
+	return client
+}
+
+// schemaPath returns the path of database/schema.sql in this repository.
+func schemaPath() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "database", "schema.sql")
+}
+
+// jsonColumnsInterceptor retypes the given STRING columns of streamed results
+// as JSON.
+func jsonColumnsInterceptor(columns map[string]bool) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonColumnsStream{ClientStream: stream, columns: columns}, nil
+	}
+}
+
+type jsonColumnsStream struct {
+	grpc.ClientStream
+	columns map[string]bool
+}
+
+func (s *jsonColumnsStream) RecvMsg(m interface{}) error {
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if prs, ok := m.(*spannerpb.PartialResultSet); ok && prs.Metadata != nil && prs.Metadata.RowType != nil {
+		for _, field := range prs.Metadata.RowType.Fields {
+			if s.columns[field.Name] && field.Type.GetCode() == spannerpb.TypeCode_STRING {
+				field.Type = &spannerpb.Type{Code: spannerpb.TypeCode_JSON}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/alphauslabs/jennah/internal/envelope"
+)
+
+// ErrEncryptionDisabled is returned by DecryptedSpec for a job with encrypted
+// env vars when the client has no key-encryption key.
+var ErrEncryptionDisabled = errors.New("job env vars are encrypted but no key encryption key is configured")
+
+// DecryptedSpec decodes the job's stored JobSpec like Job.Spec, with env var
+// values decrypted. Only code that resubmits the job, or serves a caller who
+// explicitly asked for the values, should use it.
+func (c *Client) DecryptedSpec(ctx context.Context, job *Job) (*JobSpec, error) {
+	spec, err := job.Spec()
+	if err != nil || spec == nil || !spec.EnvVarsEncrypted {
+		return spec, err
+	}
+	if c.kek == nil {
+		return nil, ErrEncryptionDisabled
+	}
+	key, err := c.tenantDataKey(ctx, job.TenantId, false)
+	if err != nil {
+		return nil, err
+	}
+	if spec.EnvVars, err = openEnvVars(key, envAAD(job.TenantId, job.JobId, -1), spec.EnvVars); err != nil {
+		return nil, err
+	}
+	for i := range spec.Steps {
+		if spec.Steps[i].EnvVars, err = openEnvVars(key, envAAD(job.TenantId, job.JobId, i), spec.Steps[i].EnvVars); err != nil {
+			return nil, err
+		}
+	}
+	spec.EnvVarsEncrypted = false
+	return spec, nil
+}
+
+// sealedSpec returns the form of spec to store for a new job: a copy with env
+// var values sealed under the tenant's data key, or spec itself when
+// encryption is disabled or there is nothing to encrypt.
+func (c *Client) sealedSpec(ctx context.Context, tenantID, jobID string, spec *JobSpec) (*JobSpec, error) {
+	if c.kek == nil || !spec.hasEnvVars() {
+		return spec, nil
+	}
+	key, err := c.tenantDataKey(ctx, tenantID, true)
+	if err != nil {
+		return nil, err
+	}
+	sealed := *spec
+	if sealed.EnvVars, err = sealEnvVars(key, envAAD(tenantID, jobID, -1), spec.EnvVars); err != nil {
+		return nil, err
+	}
+	if spec.Steps != nil {
+		sealed.Steps = make([]Step, len(spec.Steps))
+		for i, step := range spec.Steps {
+			if step.EnvVars, err = sealEnvVars(key, envAAD(tenantID, jobID, i), step.EnvVars); err != nil {
+				return nil, err
+			}
+			sealed.Steps[i] = step
+		}
+	}
+	sealed.EnvVarsEncrypted = true
+	return &sealed, nil
+}
+
+// hasEnvVars reports whether the spec sets any job or step env vars.
+func (s *JobSpec) hasEnvVars() bool {
+	if len(s.EnvVars) > 0 {
+		return true
+	}
+	for _, step := range s.Steps {
+		if len(step.EnvVars) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// envAAD binds a sealed env var to its job and step (-1 for job env vars),
+// so values cannot be moved between jobs. The variable name is appended.
+func envAAD(tenantID, jobID string, step int) string {
+	if step < 0 {
+		return fmt.Sprintf("tenants/%s/jobs/%s/env/", tenantID, jobID)
+	}
+	return fmt.Sprintf("tenants/%s/jobs/%s/steps/%d/env/", tenantID, jobID, step)
+}
+
+// sealEnvVars returns a copy of envVars with every value sealed.
+func sealEnvVars(key []byte, aad string, envVars map[string]string) (map[string]string, error) {
+	if envVars == nil {
+		return nil, nil
+	}
+	out := make(map[string]string, len(envVars))
+	for name, value := range envVars {
+		sealed, err := envelope.Seal(key, value, aad+name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt env var %s: %w", name, err)
+		}
+		out[name] = sealed
+	}
+	return out, nil
+}
+
+// openEnvVars returns a copy of envVars with every value decrypted.
+func openEnvVars(key []byte, aad string, envVars map[string]string) (map[string]string, error) {
+	if envVars == nil {
+		return nil, nil
+	}
+	out := make(map[string]string, len(envVars))
+	for name, value := range envVars {
+		opened, err := envelope.Open(key, value, aad+name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt env var %s: %w", name, err)
+		}
+		out[name] = opened
+	}
+	return out, nil
+}
+
+// tenantDataKey returns the tenant's unwrapped data key. With create set, a
+// tenant without one gets a new key, wrapped by the client's KEK and stored
+// in Tenants.DataKey.
+func (c *Client) tenantDataKey(ctx context.Context, tenantID string, create bool) ([]byte, error) {
+	if key, ok := c.dataKeys.Load(tenantID); ok {
+		return key.([]byte), nil
+	}
+
+	var wrapped []byte
+	var kekID spanner.NullString
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, "Tenants", spanner.Key{tenantID}, []string{"DataKey", "DataKeyKekId"})
+		if err != nil {
+			return err
+		}
+		if err := row.Columns(&wrapped, &kekID); err != nil {
+			return err
+		}
+		if wrapped != nil || !create {
+			return nil
+		}
+
+		dataKey, err := envelope.NewDataKey()
+		if err != nil {
+			return err
+		}
+		if wrapped, err = c.kek.Wrap(ctx, dataKey); err != nil {
+			return fmt.Errorf("failed to wrap data key: %w", err)
+		}
+		kekID = spanner.NullString{StringVal: c.kek.ID(), Valid: true}
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Update("Tenants", []string{"TenantId", "DataKey", "DataKeyKekId", "UpdatedAt"},
+				[]interface{}{tenantID, wrapped, kekID, spanner.CommitTimestamp}),
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data key of tenant %s: %w", tenantID, err)
+	}
+	if wrapped == nil {
+		return nil, fmt.Errorf("tenant %s has no data key", tenantID)
+	}
+	if kekID.StringVal != c.kek.ID() {
+		return nil, fmt.Errorf("data key of tenant %s is wrapped by %s, but %s is configured", tenantID, kekID.StringVal, c.kek.ID())
+	}
+
+	key, err := c.kek.Unwrap(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key of tenant %s: %w", tenantID, err)
+	}
+	c.dataKeys.Store(tenantID, key)
+	return key, nil
+}
@@ -3,30 +3,100 @@ package database
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
 )
 
-// InsertJob creates a new job with PENDING status
-func (c *Client) InsertJob(ctx context.Context, tenantID, jobID, imageUri string, commands []string) error {
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+// jobColumns lists every Jobs column read into the Job struct.
+var jobColumns = []string{
+	"TenantId", "JobId", "Status", "ImageUri", "Commands", "CreatedAt", "UpdatedAt",
+	"ScheduledAt", "StartedAt", "CompletedAt", "RetryCount", "MaxRetries", "PreemptionCount", "ErrorMessage",
+	"CloudJobResourcePath", "ResourceProfile", "CpuMillis", "MemoryMiB", "MaxRunDurationSeconds",
+	"JobSpec", "ClonedFromJobId", "NextAttemptAt", "ExitCode", "FailureCategory", "ProviderEvents", "Labels",
+}
+
+// jobColumnList is jobColumns formatted for a SELECT clause.
+var jobColumnList = strings.Join(jobColumns, ", ")
+
+// InsertJob creates a new job with PENDING status from its normalized spec,
+// recording the initial transition in the same commit. clonedFromJobID is the
+// job a rerun was cloned from, or empty. With a key-encryption key set, env
+// var values are stored encrypted; spec itself is not modified.
+//
+// A non-nil request is recorded in the same transaction; if its request ID is
+// already recorded and unexpired, nothing is written and ErrDuplicateRequest
+// is returned.
+func (c *Client) InsertJob(ctx context.Context, tenantID, jobID string, spec *JobSpec, clonedFromJobID string, request *SubmitRequest) error {
+	stored, err := c.sealedSpec(ctx, tenantID, jobID, spec)
+	if err != nil {
+		return err
+	}
+	commands := spec.Commands
+	if commands == nil {
+		commands = []string{}
+	}
+	var clonedFrom *string
+	if clonedFromJobID != "" {
+		clonedFrom = &clonedFromJobID
+	}
+	var maxRetries int64
+	if p := spec.RetryPolicy; p != nil && p.MaxAttempts > 1 {
+		maxRetries = p.MaxAttempts - 1
+	}
+	labels := spec.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	mutations := []*spanner.Mutation{
 		spanner.Insert("Jobs",
-			[]string{"TenantId", "JobId", "Status", "ImageUri", "Commands", "CreatedAt", "UpdatedAt", "RetryCount", "MaxRetries"},
-			[]interface{}{tenantID, jobID, JobStatusPending, imageUri, commands, spanner.CommitTimestamp, spanner.CommitTimestamp, 0, 3},
+			[]string{"TenantId", "JobId", "Status", "ImageUri", "Commands", "CreatedAt", "UpdatedAt", "RetryCount", "MaxRetries", "PreemptionCount",
+				"ResourceProfile", "CpuMillis", "MemoryMiB", "MaxRunDurationSeconds", "JobSpec", "ClonedFromJobId", "Labels"},
+			[]interface{}{tenantID, jobID, JobStatusPending, spec.primaryImage(), commands, spanner.CommitTimestamp, spanner.CommitTimestamp, 0, maxRetries, 0,
+				spec.ResourceProfile, spec.Resources.CPUMillis, spec.Resources.MemoryMiB, spec.Resources.MaxRunDurationSeconds,
+				spanner.NullJSON{Value: stored, Valid: true}, clonedFrom, spanner.NullJSON{Value: labels, Valid: true}},
 		),
+		transitionMutation(tenantID, jobID, nil, JobStatusPending, submitReason(clonedFromJobID)),
+		attemptMutation(tenantID, jobID, 1, JobStatusPending, nil, nil),
+	}
+	if request == nil {
+		_, err = c.client.Apply(ctx, mutations)
+		return err
+	}
+
+	_, err = c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		existing, err := readSubmitRequest(ctx, txn, tenantID, request.RequestId, time.Now())
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return ErrDuplicateRequest
+		}
+		return txn.BufferWrite(append(mutations, submitRequestMutation(request)))
 	})
 	return err
 }
 
-// InsertJobWithStatus creates a new job with a specified status
+// submitReason is the initial transition reason for a new job.
+func submitReason(clonedFromJobID string) string {
+	if clonedFromJobID != "" {
+		return "job rerun from " + clonedFromJobID
+	}
+	return "job submitted"
+}
+
+// InsertJobWithStatus creates a new job with a specified status and its initial transition
 func (c *Client) InsertJobWithStatus(ctx context.Context, tenantID, jobID, status, imageUri string, commands []string) error {
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Insert("Jobs",
 			[]string{"TenantId", "JobId", "Status", "ImageUri", "Commands", "CreatedAt", "UpdatedAt", "RetryCount", "MaxRetries"},
 			[]interface{}{tenantID, jobID, status, imageUri, commands, spanner.CommitTimestamp, spanner.CommitTimestamp, 0, 3},
 		),
+		transitionMutation(tenantID, jobID, nil, status, "job submitted"),
+		attemptMutation(tenantID, jobID, 1, status, nil, nil),
 	})
 	return err
 }
@@ -35,7 +105,7 @@ func (c *Client) InsertJobWithStatus(ctx context.Context, tenantID, jobID, statu
 func (c *Client) GetJob(ctx context.Context, tenantID, jobID string) (*Job, error) {
 	row, err := c.client.Single().ReadRow(ctx, "Jobs",
 		spanner.Key{tenantID, jobID},
-		[]string{"TenantId", "JobId", "Status", "ImageUri", "Commands", "CreatedAt", "UpdatedAt", "ScheduledAt", "StartedAt", "CompletedAt", "RetryCount", "MaxRetries", "ErrorMessage"},
+		jobColumns,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get job: %w", err)
@@ -52,7 +122,7 @@ func (c *Client) GetJob(ctx context.Context, tenantID, jobID string) (*Job, erro
 // ListJobs returns all jobs for a tenant
 func (c *Client) ListJobs(ctx context.Context, tenantID string) ([]*Job, error) {
 	stmt := spanner.Statement{
-		SQL: `SELECT TenantId, JobId, Status, ImageUri, Commands, CreatedAt, UpdatedAt, ScheduledAt, StartedAt, CompletedAt, RetryCount, MaxRetries, ErrorMessage
+		SQL: `SELECT ` + jobColumnList + `
 		      FROM Jobs 
 		      WHERE TenantId = @tenantId 
 		      ORDER BY CreatedAt DESC`,
@@ -87,7 +157,7 @@ func (c *Client) ListJobs(ctx context.Context, tenantID string) ([]*Job, error)
 // ListJobsByStatus returns jobs for a tenant filtered by status
 func (c *Client) ListJobsByStatus(ctx context.Context, tenantID, status string) ([]*Job, error) {
 	stmt := spanner.Statement{
-		SQL: `SELECT TenantId, JobId, Status, ImageUri, Commands, CreatedAt, UpdatedAt, ScheduledAt, StartedAt, CompletedAt, RetryCount, MaxRetries, ErrorMessage
+		SQL: `SELECT ` + jobColumnList + `
 		      FROM Jobs@{FORCE_INDEX=JobsByStatus}
 		      WHERE TenantId = @tenantId AND Status = @status 
 		      ORDER BY CreatedAt DESC`,
@@ -120,27 +190,152 @@ func (c *Client) ListJobsByStatus(ctx context.Context, tenantID, status string)
 	return jobs, nil
 }
 
-// UpdateJobStatus updates the status of a job
-func (c *Client) UpdateJobStatus(ctx context.Context, tenantID, jobID, status string) error {
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
-		spanner.Update("Jobs",
-			[]string{"TenantId", "JobId", "Status", "UpdatedAt"},
-			[]any{tenantID, jobID, status, spanner.CommitTimestamp},
-		),
+// ListJobsPage returns up to limit jobs for a tenant matching filter, newest
+// first (CreatedAt DESC, JobId ASC), starting after the given cursor. The
+// returned cursor is nil when there are no more jobs.
+//
+// Without a status filter the page is one bounded range scan over
+// JobsByCreatedAt. With one, each status is read as its own range scan over
+// JobsByStatus and the results are merged, so a page costs at most limit+1
+// rows per requested status no matter how many jobs the tenant has.
+func (c *Client) ListJobsPage(ctx context.Context, tenantID string, filter JobFilter, after *JobCursor, limit int) ([]*Job, *JobCursor, error) {
+	sql := `SELECT ` + jobColumnList + `
+	        FROM Jobs@{FORCE_INDEX=JobsByCreatedAt}
+	        WHERE TenantId = @tenantId`
+	if len(filter.Statuses) > 0 {
+		sql = `SELECT ` + jobColumnList + `
+		       FROM Jobs@{FORCE_INDEX=JobsByStatus}
+		       WHERE TenantId = @tenantId AND Status = @status`
+	}
+	params := map[string]interface{}{
+		"tenantId": tenantID,
+		"limit":    int64(limit + 1),
+	}
+	if !filter.CreatedAfter.IsZero() {
+		sql += ` AND CreatedAt >= @createdAfter`
+		params["createdAfter"] = filter.CreatedAfter
+	}
+	if !filter.CreatedBefore.IsZero() {
+		sql += ` AND CreatedAt < @createdBefore`
+		params["createdBefore"] = filter.CreatedBefore
+	}
+	if filter.ImageURIPrefix != "" {
+		sql += ` AND STARTS_WITH(ImageUri, @imagePrefix)`
+		params["imagePrefix"] = filter.ImageURIPrefix
+	}
+	labelKeys := make([]string, 0, len(filter.Labels))
+	for key := range filter.Labels {
+		labelKeys = append(labelKeys, key)
+	}
+	sort.Strings(labelKeys)
+	for i, key := range labelKeys {
+		// Label keys are validated, so quoting one makes a safe JSON path.
+		sql += fmt.Sprintf(` AND JSON_VALUE(Labels, '$."%s"') = @label%d`, key, i)
+		params[fmt.Sprintf("label%d", i)] = filter.Labels[key]
+	}
+	if after != nil {
+		sql += ` AND (CreatedAt < @afterCreatedAt OR (CreatedAt = @afterCreatedAt AND JobId > @afterJobId))`
+		params["afterCreatedAt"] = after.CreatedAt
+		params["afterJobId"] = after.JobID
+	}
+	sql += ` ORDER BY CreatedAt DESC, JobId ASC LIMIT @limit`
+
+	txn := c.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	var jobs []*Job
+	query := func() error {
+		iter := txn.Query(ctx, spanner.Statement{SQL: sql, Params: params})
+		return iter.Do(func(row *spanner.Row) error {
+			var job Job
+			if err := row.ToStruct(&job); err != nil {
+				return fmt.Errorf("failed to parse job: %w", err)
+			}
+			jobs = append(jobs, &job)
+			return nil
+		})
+	}
+	if len(filter.Statuses) == 0 {
+		if err := query(); err != nil {
+			return nil, nil, fmt.Errorf("failed to list jobs: %w", err)
+		}
+	}
+	for _, status := range filter.Statuses {
+		params["status"] = status
+		if err := query(); err != nil {
+			return nil, nil, fmt.Errorf("failed to list %s jobs: %w", status, err)
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		if !jobs[i].CreatedAt.Equal(jobs[j].CreatedAt) {
+			return jobs[i].CreatedAt.After(jobs[j].CreatedAt)
+		}
+		return jobs[i].JobId < jobs[j].JobId
 	})
+
+	if len(jobs) <= limit {
+		return jobs, nil, nil
+	}
+	jobs = jobs[:limit]
+	last := jobs[limit-1]
+	return jobs, &JobCursor{CreatedAt: last.CreatedAt, JobID: last.JobId}, nil
+}
+
+// ListActiveJobs returns every non-terminal job across all tenants that has
+// already been handed to a batch provider (i.e. has a CloudJobResourcePath).
+// SubmittedJobsByStatus only holds such jobs.
+func (c *Client) ListActiveJobs(ctx context.Context) ([]*Job, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + jobColumnList + `
+		      FROM Jobs@{FORCE_INDEX=SubmittedJobsByStatus}
+		      WHERE Status IN UNNEST(@statuses) AND CloudJobResourcePath IS NOT NULL`,
+		Params: map[string]interface{}{
+			"statuses": []string{JobStatusPending, JobStatusScheduled, JobStatusRunning},
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var jobs []*Job
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate active jobs: %w", err)
+		}
+
+		var job Job
+		if err := row.ToStruct(&job); err != nil {
+			return nil, fmt.Errorf("failed to parse job: %w", err)
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
+
+// UpdateJobStatus moves a job to the given status, recording the transition
+func (c *Client) UpdateJobStatus(ctx context.Context, tenantID, jobID, status, reason string) error {
+	err := c.transitionJob(ctx, tenantID, jobID, statusChange{toStatus: status, reason: reason})
 	if err != nil {
 		return fmt.Errorf("failed to update job status: %w", err)
 	}
 	return nil
 }
 
-// UpdateJobStatusAndCloudPath updates the status and cloud resource path of a job
-func (c *Client) UpdateJobStatusAndCloudPath(ctx context.Context, tenantID, jobID, status, cloudResourcePath string) error {
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
-		spanner.Update("Jobs",
-			[]string{"TenantId", "JobId", "Status", "CloudJobResourcePath", "UpdatedAt"},
-			[]any{tenantID, jobID, status, cloudResourcePath, spanner.CommitTimestamp},
-		),
+// UpdateJobStatusAndCloudPath updates the status and cloud resource path of a job.
+// The path is still written if the job is already in the given status.
+func (c *Client) UpdateJobStatusAndCloudPath(ctx context.Context, tenantID, jobID, status, cloudResourcePath, reason string) error {
+	err := c.transitionJob(ctx, tenantID, jobID, statusChange{
+		toStatus:       status,
+		reason:         reason,
+		columns:        []string{"CloudJobResourcePath"},
+		values:         []interface{}{cloudResourcePath},
+		allowUnchanged: true,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update job status and cloud path: %w", err)
@@ -148,14 +343,16 @@ func (c *Client) UpdateJobStatusAndCloudPath(ctx context.Context, tenantID, jobI
 	return nil
 }
 
-// CompleteJob marks a job as completed with a completion timestamp
-func (c *Client) CompleteJob(ctx context.Context, tenantID, jobID string) error {
-	now := time.Now()
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
-		spanner.Update("Jobs",
-			[]string{"TenantId", "JobId", "Status", "CompletedAt", "UpdatedAt"},
-			[]any{tenantID, jobID, JobStatusCompleted, now, spanner.CommitTimestamp},
-		),
+// CompleteJob marks a job as completed with a completion timestamp and what
+// the provider reported about it, if anything.
+func (c *Client) CompleteJob(ctx context.Context, tenantID, jobID string, outcome *JobOutcome) error {
+	err := c.transitionJob(ctx, tenantID, jobID, statusChange{
+		toStatus:       JobStatusCompleted,
+		reason:         "batch job completed",
+		columns:        append([]string{"CompletedAt"}, outcomeColumns...),
+		values:         append([]interface{}{time.Now()}, outcome.values()...),
+		attemptColumns: outcomeColumns,
+		attemptValues:  outcome.values(),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to complete job: %w", err)
@@ -163,14 +360,17 @@ func (c *Client) CompleteJob(ctx context.Context, tenantID, jobID string) error
 	return nil
 }
 
-// FailJob marks a job as failed with an error message
-func (c *Client) FailJob(ctx context.Context, tenantID, jobID, errorMessage string) error {
-	now := time.Now()
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
-		spanner.Update("Jobs",
-			[]string{"TenantId", "JobId", "Status", "ErrorMessage", "CompletedAt", "UpdatedAt"},
-			[]any{tenantID, jobID, JobStatusFailed, errorMessage, now, spanner.CommitTimestamp},
-		),
+// FailJob marks a job as failed with an error message, which is also the transition reason.
+// failureClass records where the current attempt failed (see FailureClasses), and
+// outcome is what the provider reported about the failure, if anything.
+func (c *Client) FailJob(ctx context.Context, tenantID, jobID, failureClass, errorMessage string, outcome *JobOutcome) error {
+	err := c.transitionJob(ctx, tenantID, jobID, statusChange{
+		toStatus:       JobStatusFailed,
+		reason:         errorMessage,
+		columns:        append([]string{"ErrorMessage", "CompletedAt", "NextAttemptAt"}, outcomeColumns...),
+		values:         append([]interface{}{errorMessage, time.Now(), nil}, outcome.values()...),
+		attemptColumns: append([]string{"FailureClass", "ErrorMessage"}, outcomeColumns...),
+		attemptValues:  append([]interface{}{failureClass, errorMessage}, outcome.values()...),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to fail job: %w", err)
@@ -180,12 +380,11 @@ func (c *Client) FailJob(ctx context.Context, tenantID, jobID, errorMessage stri
 
 // ScheduleJob marks a job as SCHEDULED with a scheduled timestamp
 func (c *Client) ScheduleJob(ctx context.Context, tenantID, jobID string) error {
-	now := time.Now()
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
-		spanner.Update("Jobs",
-			[]string{"TenantId", "JobId", "Status", "ScheduledAt", "UpdatedAt"},
-			[]any{tenantID, jobID, JobStatusScheduled, now, spanner.CommitTimestamp},
-		),
+	err := c.transitionJob(ctx, tenantID, jobID, statusChange{
+		toStatus: JobStatusScheduled,
+		reason:   "batch job scheduled",
+		columns:  []string{"ScheduledAt"},
+		values:   []interface{}{time.Now()},
 	})
 	if err != nil {
 		return fmt.Errorf("failed to schedule job: %w", err)
@@ -195,12 +394,11 @@ func (c *Client) ScheduleJob(ctx context.Context, tenantID, jobID string) error
 
 // StartJob marks a job as RUNNING with a started timestamp
 func (c *Client) StartJob(ctx context.Context, tenantID, jobID string) error {
-	now := time.Now()
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
-		spanner.Update("Jobs",
-			[]string{"TenantId", "JobId", "Status", "StartedAt", "UpdatedAt"},
-			[]interface{}{tenantID, jobID, JobStatusRunning, now, spanner.CommitTimestamp},
-		),
+	err := c.transitionJob(ctx, tenantID, jobID, statusChange{
+		toStatus: JobStatusRunning,
+		reason:   "batch job started",
+		columns:  []string{"StartedAt"},
+		values:   []interface{}{time.Now()},
 	})
 	if err != nil {
 		return fmt.Errorf("failed to start job: %w", err)
@@ -208,14 +406,13 @@ func (c *Client) StartJob(ctx context.Context, tenantID, jobID string) error {
 	return nil
 }
 
-// CancelJob marks a job as CANCELLED
-func (c *Client) CancelJob(ctx context.Context, tenantID, jobID string) error {
-	now := time.Now()
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
-		spanner.Update("Jobs",
-			[]string{"TenantId", "JobId", "Status", "CompletedAt", "UpdatedAt"},
-			[]interface{}{tenantID, jobID, JobStatusCancelled, now, spanner.CommitTimestamp},
-		),
+// CancelJob marks a job as CANCELLED, recording why it was cancelled
+func (c *Client) CancelJob(ctx context.Context, tenantID, jobID, reason string) error {
+	err := c.transitionJob(ctx, tenantID, jobID, statusChange{
+		toStatus: JobStatusCancelled,
+		reason:   reason,
+		columns:  []string{"CompletedAt", "NextAttemptAt"},
+		values:   []interface{}{time.Now(), nil},
 	})
 	if err != nil {
 		return fmt.Errorf("failed to cancel job: %w", err)
@@ -1,6 +1,8 @@
 package database
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -18,20 +20,289 @@ type Tenant struct {
 
 // Job represents a deployment job
 type Job struct {
-	TenantId             string     `spanner:"TenantId"`
-	JobId                string     `spanner:"JobId"`
-	Status               string     `spanner:"Status"`
-	ImageUri             string     `spanner:"ImageUri"`
-	Commands             []string   `spanner:"Commands"`
-	CreatedAt            time.Time  `spanner:"CreatedAt"`
-	UpdatedAt            time.Time  `spanner:"UpdatedAt"`
-	ScheduledAt          *time.Time `spanner:"ScheduledAt"`
-	StartedAt            *time.Time `spanner:"StartedAt"`
-	CompletedAt          *time.Time `spanner:"CompletedAt"`
-	RetryCount           int64      `spanner:"RetryCount"`
-	MaxRetries           int64      `spanner:"MaxRetries"`
-	ErrorMessage         *string    `spanner:"ErrorMessage"`
-	CloudJobResourcePath *string    `spanner:"CloudJobResourcePath"`
+	TenantId              string           `spanner:"TenantId"`
+	JobId                 string           `spanner:"JobId"`
+	Status                string           `spanner:"Status"`
+	ImageUri              string           `spanner:"ImageUri"`
+	Commands              []string         `spanner:"Commands"`
+	CreatedAt             time.Time        `spanner:"CreatedAt"`
+	UpdatedAt             time.Time        `spanner:"UpdatedAt"`
+	ScheduledAt           *time.Time       `spanner:"ScheduledAt"`
+	StartedAt             *time.Time       `spanner:"StartedAt"`
+	CompletedAt           *time.Time       `spanner:"CompletedAt"`
+	RetryCount            int64            `spanner:"RetryCount"`
+	MaxRetries            int64            `spanner:"MaxRetries"`
+	PreemptionCount       int64            `spanner:"PreemptionCount"`
+	ErrorMessage          *string          `spanner:"ErrorMessage"`
+	CloudJobResourcePath  *string          `spanner:"CloudJobResourcePath"`
+	ResourceProfile       *string          `spanner:"ResourceProfile"`
+	CpuMillis             *int64           `spanner:"CpuMillis"`
+	MemoryMiB             *int64           `spanner:"MemoryMiB"`
+	MaxRunDurationSeconds *int64           `spanner:"MaxRunDurationSeconds"`
+	JobSpec               spanner.NullJSON `spanner:"JobSpec"`
+	ClonedFromJobId       *string          `spanner:"ClonedFromJobId"`
+	NextAttemptAt         *time.Time       `spanner:"NextAttemptAt"`
+	ExitCode              *int64           `spanner:"ExitCode"`
+	FailureCategory       *string          `spanner:"FailureCategory"`
+	ProviderEvents        spanner.NullJSON `spanner:"ProviderEvents"`
+	Labels                spanner.NullJSON `spanner:"Labels"`
+}
+
+// JobSpecVersion is the version written into new JobSpec documents. Bump it
+// when the document changes shape and keep Job.Spec able to read older ones.
+const JobSpecVersion = 1
+
+// JobSpec is the normalized submission a job was created from, stored as a
+// versioned document in the Jobs.JobSpec JSON column.
+type JobSpec struct {
+	Version          int               `json:"version"`
+	ImageURI         string            `json:"imageUri"`
+	Commands         []string          `json:"commands,omitempty"`
+	Entrypoint       string            `json:"entrypoint,omitempty"`
+	ContainerOptions *ContainerOptions `json:"containerOptions,omitempty"`
+	// Script is set instead of ImageURI for script jobs.
+	Script *Script `json:"script,omitempty"`
+	// Steps are set instead of ImageURI and Script for multi-step jobs.
+	Steps []Step `json:"steps,omitempty"`
+	// TaskCount fans the job out into identical tasks; 0 means 1.
+	TaskCount   int64 `json:"taskCount,omitempty"`
+	Parallelism int64 `json:"parallelism,omitempty"`
+	// Volumes are mounted into every runnable.
+	Volumes          []Volume          `json:"volumes,omitempty"`
+	EnvVars          map[string]string `json:"envVars,omitempty"`
+	ResourceProfile  string            `json:"resourceProfile,omitempty"`
+	ResourceOverride *ResourceValues   `json:"resourceOverride,omitempty"`
+	// Resources are the values actually sent to the batch provider.
+	Resources   ResourceValues `json:"resources"`
+	RetryPolicy *RetryPolicy   `json:"retryPolicy,omitempty"`
+	// LifecyclePolicies and MaxTaskRetries control task retries inside the
+	// batch provider, as opposed to RetryPolicy which resubmits the whole job.
+	LifecyclePolicies []LifecyclePolicy `json:"lifecyclePolicies,omitempty"`
+	MaxTaskRetries    int32             `json:"maxTaskRetries,omitempty"`
+	// Labels are the user's labels, also kept in the Jobs.Labels column for
+	// filtering. System labels are added at submission and not stored.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Priority and Allocation are resolved from the profile and the request,
+	// like Resources.
+	Priority   int64       `json:"priority,omitempty"`
+	Allocation *Allocation `json:"allocation,omitempty"`
+	// PreemptionPolicy resubmits the job when the provider reclaims its VMs.
+	// Nil (older jobs) means the worker default.
+	PreemptionPolicy *PreemptionPolicy `json:"preemptionPolicy,omitempty"`
+	// SecretEnvVars reference secrets by name and version, resolved at each
+	// submission. Secret values are never stored.
+	SecretEnvVars map[string]SecretRef `json:"secretEnvVars,omitempty"`
+	// EnvVarsEncrypted is set on stored specs whose EnvVars and step EnvVars
+	// values are sealed with the tenant's data key. Use Client.DecryptedSpec
+	// to read them.
+	EnvVarsEncrypted bool `json:"envVarsEncrypted,omitempty"`
+}
+
+// RedactedEnvValue replaces env var values in API responses.
+const RedactedEnvValue = "<redacted>"
+
+// RedactEnvVars replaces every job and step env var value with
+// RedactedEnvValue, keeping the names.
+func (s *JobSpec) RedactEnvVars() {
+	s.EnvVars = redacted(s.EnvVars)
+	if s.Steps != nil {
+		steps := make([]Step, len(s.Steps))
+		for i, step := range s.Steps {
+			step.EnvVars = redacted(step.EnvVars)
+			steps[i] = step
+		}
+		s.Steps = steps
+	}
+}
+
+// redacted returns a copy of envVars with every value redacted.
+func redacted(envVars map[string]string) map[string]string {
+	if envVars == nil {
+		return nil
+	}
+	out := make(map[string]string, len(envVars))
+	for k := range envVars {
+		out[k] = RedactedEnvValue
+	}
+	return out
+}
+
+// SecretRef references one version of a secret.
+type SecretRef struct {
+	Secret  string `json:"secret"`
+	Version string `json:"version"`
+}
+
+// PreemptionPolicy controls resubmission of preempted jobs.
+type PreemptionPolicy struct {
+	// MaxRetries is how many times a preempted job is resubmitted as submitted.
+	MaxRetries int64 `json:"maxRetries"`
+	// FallbackToStandard allows one more attempt on STANDARD VMs once a SPOT
+	// job has used MaxRetries.
+	FallbackToStandard bool `json:"fallbackToStandard,omitempty"`
+}
+
+// Allocation chooses the VMs a job runs on.
+type Allocation struct {
+	MachineType       string   `json:"machineType,omitempty"`
+	ProvisioningModel string   `json:"provisioningModel,omitempty"`
+	Zones             []string `json:"zones,omitempty"`
+}
+
+// ContainerOptions change how a job's container is run.
+type ContainerOptions struct {
+	WorkingDir string `json:"workingDir,omitempty"`
+	User       string `json:"user,omitempty"`
+}
+
+// primaryImage is the image recorded in Jobs.ImageUri: the job's image, or the
+// first container step's for multi-step jobs. Script-only jobs have none.
+func (s *JobSpec) primaryImage() string {
+	if s.ImageURI != "" {
+		return s.ImageURI
+	}
+	for _, step := range s.Steps {
+		if step.ImageURI != "" {
+			return step.ImageURI
+		}
+	}
+	return ""
+}
+
+// Step is one runnable of a multi-step job: a container (ImageURI), a Script
+// or a Barrier.
+type Step struct {
+	Name             string            `json:"name,omitempty"`
+	ImageURI         string            `json:"imageUri,omitempty"`
+	Commands         []string          `json:"commands,omitempty"`
+	Entrypoint       string            `json:"entrypoint,omitempty"`
+	ContainerOptions *ContainerOptions `json:"containerOptions,omitempty"`
+	Script           *Script           `json:"script,omitempty"`
+	Barrier          bool              `json:"barrier,omitempty"`
+	EnvVars          map[string]string `json:"envVars,omitempty"`
+	Background       bool              `json:"background,omitempty"`
+	IgnoreExitStatus bool              `json:"ignoreExitStatus,omitempty"`
+}
+
+// Script is an inline runnable used instead of a container image.
+type Script struct {
+	Text        string `json:"text"`
+	Interpreter string `json:"interpreter,omitempty"`
+}
+
+// Volume is storage mounted into a job's runnables. Exactly one of GCS, NFS
+// and Disk is set.
+type Volume struct {
+	MountPath string      `json:"mountPath"`
+	ReadOnly  bool        `json:"readOnly,omitempty"`
+	GCS       *GCSVolume  `json:"gcs,omitempty"`
+	NFS       *NFSVolume  `json:"nfs,omitempty"`
+	Disk      *DiskVolume `json:"disk,omitempty"`
+}
+
+// GCSVolume is a Cloud Storage bucket, or a folder within one.
+type GCSVolume struct {
+	Bucket string `json:"bucket"`
+	Path   string `json:"path,omitempty"`
+}
+
+// NFSVolume is a directory exported by an NFS server.
+type NFSVolume struct {
+	Server     string `json:"server"`
+	RemotePath string `json:"remotePath"`
+}
+
+// DiskVolume is an empty disk created for each task.
+type DiskVolume struct {
+	SizeGB int64  `json:"sizeGb"`
+	Type   string `json:"type,omitempty"`
+}
+
+// LifecyclePolicy maps container exit codes to a provider action, either
+// LifecycleActionRetryTask or LifecycleActionFailTask.
+type LifecyclePolicy struct {
+	Action    string  `json:"action"`
+	ExitCodes []int32 `json:"exitCodes"`
+}
+
+// Lifecycle policy actions.
+const (
+	LifecycleActionRetryTask = "RETRY_TASK"
+	LifecycleActionFailTask  = "FAIL_TASK"
+)
+
+// RetryPolicy controls automatic resubmission of a failed job. MaxAttempts
+// counts the first run, so MaxAttempts 3 allows two retries.
+type RetryPolicy struct {
+	MaxAttempts           int64    `json:"maxAttempts"`
+	InitialBackoffSeconds int64    `json:"initialBackoffSeconds"`
+	MaxBackoffSeconds     int64    `json:"maxBackoffSeconds"`
+	BackoffMultiplier     float64  `json:"backoffMultiplier"`
+	RetryOn               []string `json:"retryOn"`
+}
+
+// Failure classes say where a job failed; RetryPolicy.RetryOn selects among them.
+const (
+	// FailureClassSubmission means the batch provider rejected the job.
+	FailureClassSubmission = "SUBMISSION"
+	// FailureClassRuntime means the provider accepted the job and later reported it failed.
+	FailureClassRuntime = "RUNTIME"
+)
+
+// FailureClasses lists every failure class.
+var FailureClasses = []string{FailureClassSubmission, FailureClassRuntime}
+
+// RetriesOn reports whether the policy retries failures of the given class.
+func (p *RetryPolicy) RetriesOn(class string) bool {
+	for _, c := range p.RetryOn {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the delay before retry number retry (1-based):
+// InitialBackoffSeconds grown by BackoffMultiplier per retry, capped at MaxBackoffSeconds.
+func (p *RetryPolicy) Backoff(retry int64) time.Duration {
+	delay := float64(p.InitialBackoffSeconds)
+	for i := int64(1); i < retry; i++ {
+		delay *= p.BackoffMultiplier
+		if delay >= float64(p.MaxBackoffSeconds) {
+			break
+		}
+	}
+	if delay > float64(p.MaxBackoffSeconds) {
+		delay = float64(p.MaxBackoffSeconds)
+	}
+	return time.Duration(delay * float64(time.Second))
+}
+
+// ResourceValues are compute resources in provider-neutral units.
+type ResourceValues struct {
+	CPUMillis             int64 `json:"cpuMillis"`
+	MemoryMiB             int64 `json:"memoryMiB"`
+	MaxRunDurationSeconds int64 `json:"maxRunDurationSeconds,omitempty"`
+}
+
+// Spec decodes the job's stored JobSpec. It returns nil for jobs created
+// before the column existed.
+func (j *Job) Spec() (*JobSpec, error) {
+	if !j.JobSpec.Valid {
+		return nil, nil
+	}
+	raw, err := json.Marshal(j.JobSpec.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read job spec: %w", err)
+	}
+	var spec JobSpec
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse job spec: %w", err)
+	}
+	if spec.Version < 1 || spec.Version > JobSpecVersion {
+		return nil, fmt.Errorf("unsupported job spec version %d", spec.Version)
+	}
+	return &spec, nil
 }
 
 // JobStateTransition tracks state changes for audit trail
@@ -45,6 +316,123 @@ type JobStateTransition struct {
 	Reason         *string   `spanner:"Reason"`
 }
 
+// JobAttempt is one submission of a job to the batch provider. Attempts are
+// numbered from 1; a job's current attempt is RetryCount+1. Besides the job
+// statuses, an attempt may end AttemptStatusPreempted.
+type JobAttempt struct {
+	TenantId             string           `spanner:"TenantId"`
+	JobId                string           `spanner:"JobId"`
+	AttemptNumber        int64            `spanner:"AttemptNumber"`
+	Status               string           `spanner:"Status"`
+	ProviderJobId        *string          `spanner:"ProviderJobId"`
+	ProviderJobUid       *string          `spanner:"ProviderJobUid"`
+	CloudJobResourcePath *string          `spanner:"CloudJobResourcePath"`
+	FailureClass         *string          `spanner:"FailureClass"`
+	ErrorMessage         *string          `spanner:"ErrorMessage"`
+	StartedAt            *time.Time       `spanner:"StartedAt"`
+	EndedAt              *time.Time       `spanner:"EndedAt"`
+	ExitCode             *int64           `spanner:"ExitCode"`
+	FailureCategory      *string          `spanner:"FailureCategory"`
+	ProviderEvents       spanner.NullJSON `spanner:"ProviderEvents"`
+}
+
+// SubmitRequest records the job created for a client-supplied SubmitJob
+// request ID, so a repeated request returns the same job. Rows expire at
+// ExpiresAt.
+type SubmitRequest struct {
+	TenantId  string `spanner:"TenantId"`
+	RequestId string `spanner:"RequestId"`
+	// PayloadHash identifies the request payload, excluding the request ID.
+	PayloadHash string `spanner:"PayloadHash"`
+	JobId       string `spanner:"JobId"`
+	// Status is the status SubmitJob returned, set once it has responded.
+	Status *string `spanner:"Status"`
+	// ErrorCode and ErrorMessage are set instead when SubmitJob failed after
+	// creating the job. ErrorCode is a connect code name such as "internal".
+	ErrorCode    *string   `spanner:"ErrorCode"`
+	ErrorMessage *string   `spanner:"ErrorMessage"`
+	CreatedAt    time.Time `spanner:"CreatedAt"`
+	ExpiresAt    time.Time `spanner:"ExpiresAt"`
+}
+
+// ProviderEvent is one status event reported by the batch provider.
+type ProviderEvent struct {
+	Time        time.Time `json:"time"`
+	Type        string    `json:"type,omitempty"`
+	Description string    `json:"description"`
+}
+
+// JobOutcome is what the batch provider reported about a finished job or
+// attempt. Any field may be empty when the provider did not report it.
+type JobOutcome struct {
+	ExitCode        *int64
+	FailureCategory string
+	Events          []ProviderEvent
+}
+
+// values returns the outcome as ExitCode, FailureCategory and ProviderEvents
+// column values, all NULL for a nil outcome.
+func (o *JobOutcome) values() []interface{} {
+	if o == nil {
+		return []interface{}{nil, nil, nil}
+	}
+	var category *string
+	if o.FailureCategory != "" {
+		category = &o.FailureCategory
+	}
+	events := spanner.NullJSON{}
+	if len(o.Events) > 0 {
+		events = spanner.NullJSON{Value: o.Events, Valid: true}
+	}
+	return []interface{}{o.ExitCode, category, events}
+}
+
+// outcomeColumns are the columns JobOutcome.values fills, on both Jobs and JobAttempts.
+var outcomeColumns = []string{"ExitCode", "FailureCategory", "ProviderEvents"}
+
+// decodeProviderEvents reads a ProviderEvents column.
+func decodeProviderEvents(column spanner.NullJSON) ([]ProviderEvent, error) {
+	if !column.Valid {
+		return nil, nil
+	}
+	raw, err := json.Marshal(column.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read provider events: %w", err)
+	}
+	var events []ProviderEvent
+	if err := json.Unmarshal(raw, &events); err != nil {
+		return nil, fmt.Errorf("failed to parse provider events: %w", err)
+	}
+	return events, nil
+}
+
+// Events decodes the provider events stored with the job.
+func (j *Job) Events() ([]ProviderEvent, error) {
+	return decodeProviderEvents(j.ProviderEvents)
+}
+
+// LabelMap decodes the job's labels. Jobs created before labels were stored
+// have none.
+func (j *Job) LabelMap() (map[string]string, error) {
+	if !j.Labels.Valid {
+		return nil, nil
+	}
+	raw, err := json.Marshal(j.Labels.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read labels: %w", err)
+	}
+	var labels map[string]string
+	if err := json.Unmarshal(raw, &labels); err != nil {
+		return nil, fmt.Errorf("failed to parse labels: %w", err)
+	}
+	return labels, nil
+}
+
+// Events decodes the provider events stored with the attempt.
+func (a *JobAttempt) Events() ([]ProviderEvent, error) {
+	return decodeProviderEvents(a.ProviderEvents)
+}
+
 // JobStatus constants
 const (
 	JobStatusPending   = "PENDING"
@@ -54,3 +442,68 @@ const (
 	JobStatusFailed    = "FAILED"
 	JobStatusCancelled = "CANCELLED"
 )
+
+// AttemptStatusPreempted ends an attempt whose VM the provider reclaimed. The
+// job itself goes back to PENDING, or on to FAILED when out of preemption retries.
+const AttemptStatusPreempted = "PREEMPTED"
+
+// JobStatuses lists every job status.
+var JobStatuses = []string{
+	JobStatusPending, JobStatusScheduled, JobStatusRunning,
+	JobStatusCompleted, JobStatusFailed, JobStatusCancelled,
+}
+
+// IsJobStatus reports whether status is a known job status.
+func IsJobStatus(status string) bool {
+	for _, s := range JobStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminalStatus reports whether a job in the given status will not change again.
+func IsTerminalStatus(status string) bool {
+	switch status {
+	case JobStatusCompleted, JobStatusFailed, JobStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// allowedTransitions is the job lifecycle graph: each status maps to the
+// statuses a job may move to next. FAILED → PENDING is the retry edge.
+var allowedTransitions = map[string][]string{
+	JobStatusPending:   {JobStatusScheduled, JobStatusRunning, JobStatusCompleted, JobStatusFailed, JobStatusCancelled},
+	JobStatusScheduled: {JobStatusPending, JobStatusRunning, JobStatusCompleted, JobStatusFailed, JobStatusCancelled},
+	JobStatusRunning:   {JobStatusPending, JobStatusCompleted, JobStatusFailed, JobStatusCancelled},
+	JobStatusFailed:    {JobStatusPending},
+}
+
+// CanTransition reports whether a job may move from one status to another.
+func CanTransition(from, to string) bool {
+	for _, next := range allowedTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// JobFilter narrows ListJobsPage results. Zero values match everything.
+type JobFilter struct {
+	Statuses       []string
+	CreatedAfter   time.Time // inclusive
+	CreatedBefore  time.Time // exclusive
+	ImageURIPrefix string
+	// Labels keeps jobs that have every one of these labels.
+	Labels map[string]string
+}
+
+// JobCursor is the position of the last job on a page, in ListJobsPage order.
+type JobCursor struct {
+	CreatedAt time.Time
+	JobID     string
+}
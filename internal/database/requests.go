@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrDuplicateRequest is returned by InsertJob when an unexpired
+// SubmitRequests row already holds the request ID, e.g. because a concurrent
+// retry of the same request won the race.
+var ErrDuplicateRequest = errors.New("request ID has already been used")
+
+// submitRequestColumns lists every SubmitRequests column read into the
+// SubmitRequest struct.
+var submitRequestColumns = []string{"TenantId", "RequestId", "PayloadHash", "JobId", "Status", "ErrorCode", "ErrorMessage", "CreatedAt", "ExpiresAt"}
+
+// GetSubmitRequest returns the unexpired record of a request ID, or nil if
+// there is none. Expired rows are ignored until Spanner's row deletion
+// policy removes them.
+func (c *Client) GetSubmitRequest(ctx context.Context, tenantID, requestID string) (*SubmitRequest, error) {
+	return readSubmitRequest(ctx, c.client.Single(), tenantID, requestID, time.Now())
+}
+
+// RecordSubmitResponse stores the status SubmitJob returned for a request ID,
+// so a repeated request returns the same response.
+func (c *Client) RecordSubmitResponse(ctx context.Context, tenantID, requestID, status string) error {
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Update("SubmitRequests", []string{"TenantId", "RequestId", "Status"},
+			[]interface{}{tenantID, requestID, status}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record submit response: %w", err)
+	}
+	return nil
+}
+
+// RecordSubmitError stores the error SubmitJob returned for a request ID after
+// creating its job, so a repeated request fails the same way instead of
+// reporting the job as submitted.
+func (c *Client) RecordSubmitError(ctx context.Context, tenantID, requestID, code, message string) error {
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Update("SubmitRequests", []string{"TenantId", "RequestId", "ErrorCode", "ErrorMessage"},
+			[]interface{}{tenantID, requestID, code, message}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record submit error: %w", err)
+	}
+	return nil
+}
+
+// submitRequestMutation records the job created for a request, replacing an
+// expired row with the same ID.
+func submitRequestMutation(request *SubmitRequest) *spanner.Mutation {
+	return spanner.InsertOrUpdate("SubmitRequests",
+		[]string{"TenantId", "RequestId", "PayloadHash", "JobId", "Status", "ErrorCode", "ErrorMessage", "CreatedAt", "ExpiresAt"},
+		[]interface{}{request.TenantId, request.RequestId, request.PayloadHash, request.JobId, nil, nil, nil, spanner.CommitTimestamp, request.ExpiresAt})
+}
+
+// rowReader is satisfied by Spanner's read-only and read-write transactions.
+type rowReader interface {
+	ReadRow(ctx context.Context, table string, key spanner.Key, columns []string) (*spanner.Row, error)
+}
+
+// readSubmitRequest reads a request ID's record, returning nil when it is
+// missing or expired at now.
+func readSubmitRequest(ctx context.Context, txn rowReader, tenantID, requestID string, now time.Time) (*SubmitRequest, error) {
+	row, err := txn.ReadRow(ctx, "SubmitRequests", spanner.Key{tenantID, requestID}, submitRequestColumns)
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submit request: %w", err)
+	}
+	var request SubmitRequest
+	if err := row.ToStruct(&request); err != nil {
+		return nil, fmt.Errorf("failed to parse submit request: %w", err)
+	}
+	if !request.ExpiresAt.After(now) {
+		return nil, nil
+	}
+	return &request, nil
+}
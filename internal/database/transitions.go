@@ -2,12 +2,87 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
 	"google.golang.org/api/iterator"
 )
 
+// ErrInvalidTransition is returned when a status change is not allowed from
+// the job's current status, including when another writer changed it first.
+var ErrInvalidTransition = errors.New("invalid job status transition")
+
+// statusChange describes a single job status update applied by transitionJob.
+type statusChange struct {
+	toStatus string
+	reason   string
+	// columns and values are extra Jobs columns written with the status.
+	columns []string
+	values  []interface{}
+	// attemptColumns and attemptValues are extra JobAttempts columns written
+	// for the job's current attempt.
+	attemptColumns []string
+	attemptValues  []interface{}
+	// allowUnchanged writes columns without recording a transition when the
+	// job is already in toStatus, instead of rejecting the change.
+	allowUnchanged bool
+}
+
+// transitionJob moves a job to a new status in one read-write transaction:
+// it reads the current status, checks it against the lifecycle graph, and
+// buffers the Jobs update, the JobStateTransitions row and the status of the
+// current JobAttempts row.
+func (c *Client) transitionJob(ctx context.Context, tenantID, jobID string, change statusChange) error {
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, "Jobs", spanner.Key{tenantID, jobID}, []string{"Status", "RetryCount"})
+		if err != nil {
+			return err
+		}
+		var fromStatus string
+		var retryCount int64
+		if err := row.Columns(&fromStatus, &retryCount); err != nil {
+			return err
+		}
+
+		columns := append([]string{"TenantId", "JobId", "UpdatedAt"}, change.columns...)
+		values := append([]interface{}{tenantID, jobID, spanner.CommitTimestamp}, change.values...)
+		attempt := attemptMutation(tenantID, jobID, retryCount+1, change.toStatus, change.attemptColumns, change.attemptValues)
+
+		if fromStatus == change.toStatus && change.allowUnchanged {
+			return txn.BufferWrite([]*spanner.Mutation{spanner.Update("Jobs", columns, values), attempt})
+		}
+		if !CanTransition(fromStatus, change.toStatus) {
+			return fmt.Errorf("%w: %s → %s", ErrInvalidTransition, fromStatus, change.toStatus)
+		}
+
+		columns = append(columns, "Status")
+		values = append(values, change.toStatus)
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Update("Jobs", columns, values),
+			transitionMutation(tenantID, jobID, &fromStatus, change.toStatus, change.reason),
+			attempt,
+		})
+	})
+	return err
+}
+
+// transitionMutation builds the JobStateTransitions insert for one status change.
+// Transition IDs are time-ordered so several transitions written in one commit
+// (and so sharing a commit timestamp) still sort in the order they were made.
+func transitionMutation(tenantID, jobID string, fromStatus *string, toStatus, reason string) *spanner.Mutation {
+	var reasonValue *string
+	if reason != "" {
+		reasonValue = &reason
+	}
+	return spanner.Insert("JobStateTransitions",
+		[]string{"TenantId", "JobId", "TransitionId", "FromStatus", "ToStatus", "TransitionedAt", "Reason"},
+		[]interface{}{tenantID, jobID, uuid.Must(uuid.NewV7()).String(), fromStatus, toStatus, spanner.CommitTimestamp, reasonValue},
+	)
+}
+
 // RecordStateTransition creates a new state transition record
 func (c *Client) RecordStateTransition(ctx context.Context, tenantID, jobID, transitionID string, fromStatus *string, toStatus string, reason *string) error {
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
@@ -22,13 +97,13 @@ func (c *Client) RecordStateTransition(ctx context.Context, tenantID, jobID, tra
 	return nil
 }
 
-// GetJobTransitions retrieves all state transitions for a job
+// GetJobTransitions retrieves all state transitions for a job, oldest first
 func (c *Client) GetJobTransitions(ctx context.Context, tenantID, jobID string) ([]*JobStateTransition, error) {
 	stmt := spanner.Statement{
 		SQL: `SELECT TenantId, JobId, TransitionId, FromStatus, ToStatus, TransitionedAt, Reason 
 		      FROM JobStateTransitions 
 		      WHERE TenantId = @tenantId AND JobId = @jobId 
-		      ORDER BY TransitionedAt DESC`,
+		      ORDER BY TransitionedAt ASC, TransitionId ASC`,
 		Params: map[string]interface{}{
 			"tenantId": tenantID,
 			"jobId":    jobID,
@@ -57,3 +132,69 @@ func (c *Client) GetJobTransitions(ctx context.Context, tenantID, jobID string)
 
 	return transitions, nil
 }
+
+// ListTransitionsAfter returns a tenant's transitions that sort after the
+// (afterTime, afterID) cursor, oldest first. An empty jobID covers every job of
+// the tenant. TransitionId breaks ties between equal commit timestamps.
+func (c *Client) ListTransitionsAfter(ctx context.Context, tenantID, jobID string, afterTime time.Time, afterID string) ([]*JobStateTransition, error) {
+	// A single job's history is read through TransitionsByJob; a tenant-wide
+	// range needs TransitionsByTenantTime.
+	table := "JobStateTransitions"
+	if jobID == "" {
+		table = "JobStateTransitions@{FORCE_INDEX=TransitionsByTenantTime}"
+	}
+	sql := `SELECT TenantId, JobId, TransitionId, FromStatus, ToStatus, TransitionedAt, Reason
+	        FROM ` + table + `
+	        WHERE TenantId = @tenantId
+	          AND (TransitionedAt > @afterTime OR (TransitionedAt = @afterTime AND TransitionId > @afterId))`
+	params := map[string]interface{}{
+		"tenantId":  tenantID,
+		"afterTime": afterTime,
+		"afterId":   afterID,
+	}
+	if jobID != "" {
+		sql += ` AND JobId = @jobId`
+		params["jobId"] = jobID
+	}
+	sql += ` ORDER BY TransitionedAt ASC, TransitionId ASC`
+
+	iter := c.client.Single().Query(ctx, spanner.Statement{SQL: sql, Params: params})
+	defer iter.Stop()
+
+	var transitions []*JobStateTransition
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate transitions: %w", err)
+		}
+
+		var transition JobStateTransition
+		if err := row.ToStruct(&transition); err != nil {
+			return nil, fmt.Errorf("failed to parse transition: %w", err)
+		}
+		transitions = append(transitions, &transition)
+	}
+
+	return transitions, nil
+}
+
+// CurrentTimestamp returns Spanner's current time. Cursors over commit
+// timestamps start from it rather than from the caller's clock, which may be
+// skewed against Spanner's.
+func (c *Client) CurrentTimestamp(ctx context.Context) (time.Time, error) {
+	iter := c.client.Single().Query(ctx, spanner.Statement{SQL: `SELECT CURRENT_TIMESTAMP()`})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to read current timestamp: %w", err)
+	}
+	var now time.Time
+	if err := row.Columns(&now); err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse current timestamp: %w", err)
+	}
+	return now, nil
+}
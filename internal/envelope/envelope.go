@@ -0,0 +1,120 @@
+// Package envelope implements envelope encryption: values are sealed with a
+// data key, and data keys are stored wrapped by a key-encryption key (KEK)
+// that never leaves its provider.
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DataKeySize is the size of a data key in bytes (AES-256).
+const DataKeySize = 32
+
+// sealedPrefix marks and versions values produced by Seal.
+const sealedPrefix = "enc:v1:"
+
+// KeyEncryptionKey wraps and unwraps data keys.
+type KeyEncryptionKey interface {
+	// ID identifies the key, so data keys wrapped by another KEK are
+	// detected instead of failing to decrypt.
+	ID() string
+
+	// Wrap encrypts a data key for storage.
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+
+	// Unwrap decrypts a data key returned by Wrap.
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// Config selects and configures a KeyEncryptionKey.
+type Config struct {
+	// Provider is "keyfile", or empty to disable encryption.
+	Provider string
+
+	// KeyFile is the path of the keyfile KEK.
+	KeyFile string
+}
+
+// NewKEK creates the KEK named by config. It returns nil when encryption is
+// disabled.
+func NewKEK(config Config) (KeyEncryptionKey, error) {
+	switch config.Provider {
+	case "":
+		return nil, nil
+	case "keyfile":
+		if config.KeyFile == "" {
+			return nil, errors.New("key file is required for the keyfile KEK")
+		}
+		return LoadKeyfileKEK(config.KeyFile)
+	default:
+		return nil, fmt.Errorf("unsupported key encryption key provider: %s", config.Provider)
+	}
+}
+
+// NewDataKey returns a random data key.
+func NewDataKey() ([]byte, error) {
+	key := make([]byte, DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	return key, nil
+}
+
+// Seal encrypts plaintext with AES-GCM under key. aad binds the value to its
+// context (e.g. the record and field it is stored in) and must be passed to
+// Open unchanged. The result is printable text.
+func Seal(key []byte, plaintext, aad string) (string, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(aad))
+	return sealedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value produced by Seal.
+func Open(key []byte, value, aad string) (string, error) {
+	encoded, ok := strings.CutPrefix(value, sealedPrefix)
+	if !ok {
+		return "", errors.New("value is not sealed")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode sealed value: %w", err)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("sealed value is truncated")
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(aad))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt sealed value: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// newAEAD returns AES-GCM for a data key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != DataKeySize {
+		return nil, fmt.Errorf("data key must be %d bytes, got %d", DataKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
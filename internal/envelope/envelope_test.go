@@ -0,0 +1,140 @@
+package envelope
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestKey(t *testing.T) []byte {
+	t.Helper()
+	key, err := NewDataKey()
+	if err != nil {
+		t.Fatalf("NewDataKey: %v", err)
+	}
+	return key
+}
+
+func TestSealOpenRoundTrip(t *testing.T) {
+	key := newTestKey(t)
+	for _, plaintext := range []string{"", "hunter2", strings.Repeat("x", 4096)} {
+		sealed, err := Seal(key, plaintext, "tenant-1/job-1/TOKEN")
+		if err != nil {
+			t.Fatalf("Seal: %v", err)
+		}
+		if !strings.HasPrefix(sealed, sealedPrefix) {
+			t.Errorf("sealed value %q lacks prefix %q", sealed, sealedPrefix)
+		}
+		if plaintext != "" && strings.Contains(sealed, plaintext) {
+			t.Errorf("sealed value contains the plaintext")
+		}
+		opened, err := Open(key, sealed, "tenant-1/job-1/TOKEN")
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		if opened != plaintext {
+			t.Errorf("Open = %q, want %q", opened, plaintext)
+		}
+	}
+}
+
+func TestSealUsesFreshNonces(t *testing.T) {
+	key := newTestKey(t)
+	a, err := Seal(key, "hunter2", "aad")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Seal(key, "hunter2", "aad")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b {
+		t.Error("sealing the same value twice gave the same ciphertext")
+	}
+}
+
+func TestOpenRejects(t *testing.T) {
+	key := newTestKey(t)
+	sealed, err := Seal(key, "hunter2", "tenant-1/job-1/TOKEN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(sealed, sealedPrefix))
+	raw[len(raw)-1] ^= 1
+	tampered := sealedPrefix + base64.StdEncoding.EncodeToString(raw)
+
+	tests := []struct {
+		name  string
+		key   []byte
+		value string
+		aad   string
+	}{
+		{"wrong aad", key, sealed, "tenant-1/job-2/TOKEN"},
+		{"wrong key", newTestKey(t), sealed, "tenant-1/job-1/TOKEN"},
+		{"tampered", key, tampered, "tenant-1/job-1/TOKEN"},
+		{"not sealed", key, "hunter2", "tenant-1/job-1/TOKEN"},
+		{"truncated", key, sealedPrefix + "AAAA", "tenant-1/job-1/TOKEN"},
+		{"short key", key[:16], sealed, "tenant-1/job-1/TOKEN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := Open(tt.key, tt.value, tt.aad); err == nil {
+				t.Errorf("Open = %q, want error", got)
+			}
+		})
+	}
+}
+
+func TestKeyfileKEK(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kek")
+	if err := os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(newTestKey(t))+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	kek, err := LoadKeyfileKEK(path)
+	if err != nil {
+		t.Fatalf("LoadKeyfileKEK: %v", err)
+	}
+	if !strings.HasPrefix(kek.ID(), "keyfile:") {
+		t.Errorf("ID = %q, want keyfile: prefix", kek.ID())
+	}
+
+	ctx := context.Background()
+	dataKey := newTestKey(t)
+	wrapped, err := kek.Wrap(ctx, dataKey)
+	if err != nil {
+		t.Fatalf("Wrap: %v", err)
+	}
+	unwrapped, err := kek.Unwrap(ctx, wrapped)
+	if err != nil {
+		t.Fatalf("Unwrap: %v", err)
+	}
+	if string(unwrapped) != string(dataKey) {
+		t.Error("Unwrap did not return the wrapped data key")
+	}
+
+	other := filepath.Join(t.TempDir(), "other")
+	if err := os.WriteFile(other, []byte(base64.StdEncoding.EncodeToString(newTestKey(t))), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	otherKEK, err := LoadKeyfileKEK(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if otherKEK.ID() == kek.ID() {
+		t.Error("different keys have the same ID")
+	}
+	if _, err := otherKEK.Unwrap(ctx, wrapped); err == nil {
+		t.Error("Unwrap with another KEK succeeded")
+	}
+
+	short := filepath.Join(t.TempDir(), "short")
+	if err := os.WriteFile(short, []byte(base64.StdEncoding.EncodeToString(make([]byte, 16))), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadKeyfileKEK(short); err == nil {
+		t.Error("LoadKeyfileKEK accepted a 16-byte key")
+	}
+}
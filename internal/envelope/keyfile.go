@@ -0,0 +1,60 @@
+package envelope
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeyfileKEK is a KEK read from a local file, for development. The file holds
+// a base64-encoded 32-byte key, e.g. from `openssl rand -base64 32`.
+// Production deployments should use a KEK held in a key management service.
+type KeyfileKEK struct {
+	key []byte
+	id  string
+}
+
+// LoadKeyfileKEK reads the KEK at path.
+func LoadKeyfileKEK(path string) (*KeyfileKEK, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("key file %s is not base64: %w", path, err)
+	}
+	if len(key) != DataKeySize {
+		return nil, fmt.Errorf("key file %s must hold %d bytes, got %d", path, DataKeySize, len(key))
+	}
+	// The ID is a fingerprint, so it changes with the key but reveals nothing.
+	sum := sha256.Sum256(key)
+	return &KeyfileKEK{key: key, id: "keyfile:" + hex.EncodeToString(sum[:8])}, nil
+}
+
+// ID returns "keyfile:" and a fingerprint of the key.
+func (k *KeyfileKEK) ID() string {
+	return k.id
+}
+
+// Wrap seals the data key with the KEK.
+func (k *KeyfileKEK) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	sealed, err := Seal(k.key, base64.StdEncoding.EncodeToString(dataKey), k.id)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(sealed), nil
+}
+
+// Unwrap opens a data key sealed by Wrap.
+func (k *KeyfileKEK) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	encoded, err := Open(k.key, string(wrapped), k.id)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(encoded)
+}
@@ -0,0 +1,39 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// FileResolver resolves references to files laid out as <dir>/<name>/<version>,
+// for tests and offline development with the fake batch provider. The file
+// holds the secret value; only its path is returned.
+type FileResolver struct {
+	dir string
+}
+
+// NewFileResolver creates a resolver for secrets stored under dir.
+func NewFileResolver(dir string) *FileResolver {
+	return &FileResolver{dir: dir}
+}
+
+// Resolve returns the path of the secret version's file after checking that
+// it exists.
+func (r *FileResolver) Resolve(ctx context.Context, ref Ref) (string, error) {
+	if err := ref.Validate(); err != nil {
+		return "", err
+	}
+	path := filepath.Join(r.dir, ref.Name, ref.Version)
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) || (err == nil && info.IsDir()) {
+		return "", fmt.Errorf("%w: %s version %s", ErrNotFound, ref.Name, ref.Version)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s: %w", ref.Name, err)
+	}
+	return path, nil
+}
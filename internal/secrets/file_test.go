@@ -0,0 +1,54 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileResolver(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "db-password"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "db-password", "3"), []byte("hunter2"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "db-password", "latest"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	r := NewFileResolver(dir)
+
+	got, err := r.Resolve(context.Background(), Ref{Name: "db-password", Version: "3"})
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if want := filepath.Join(dir, "db-password", "3"); got != want {
+		t.Errorf("Resolve = %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		name     string
+		ref      Ref
+		notFound bool
+	}{
+		{"missing version", Ref{Name: "db-password", Version: "4"}, true},
+		{"missing secret", Ref{Name: "api-token", Version: LatestVersion}, true},
+		{"directory", Ref{Name: "db-password", Version: LatestVersion}, true},
+		{"path traversal", Ref{Name: "..", Version: "3"}, false},
+		{"bad version", Ref{Name: "db-password", Version: "../3"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := r.Resolve(context.Background(), tt.ref)
+			if err == nil {
+				t.Fatalf("Resolve = %q, want error", path)
+			}
+			if errors.Is(err, ErrNotFound) != tt.notFound {
+				t.Errorf("Resolve error %v, ErrNotFound = %v, want %v", err, errors.Is(err, ErrNotFound), tt.notFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+)
+
+// SecretManagerResolver resolves references to Secret Manager secrets of one
+// project. It does not call Secret Manager: GCP Batch reads the version when
+// the job starts, using the job's service account, and fails the job if the
+// version is missing or not accessible.
+type SecretManagerResolver struct {
+	projectID string
+}
+
+// NewSecretManagerResolver creates a resolver for secrets in projectID.
+func NewSecretManagerResolver(projectID string) *SecretManagerResolver {
+	return &SecretManagerResolver{projectID: projectID}
+}
+
+// Resolve returns the secret version's resource name,
+// projects/<project>/secrets/<name>/versions/<version>.
+func (r *SecretManagerResolver) Resolve(ctx context.Context, ref Ref) (string, error) {
+	if err := ref.Validate(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("projects/%s/secrets/%s/versions/%s", r.projectID, ref.Name, ref.Version), nil
+}
@@ -0,0 +1,86 @@
+// Package secrets resolves references to secrets kept outside Jennah into the
+// form batch providers hand to jobs, so secret values never pass through
+// Jennah's API, logs or database.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// LatestVersion refers to a secret's newest enabled version.
+const LatestVersion = "latest"
+
+// ErrNotFound is returned by Resolve when a referenced secret version does
+// not exist.
+var ErrNotFound = errors.New("secret not found")
+
+var (
+	// namePattern follows Secret Manager's secret ID rules.
+	namePattern    = regexp.MustCompile(`^[A-Za-z0-9_-]{1,255}$`)
+	versionPattern = regexp.MustCompile(`^(latest|[1-9][0-9]{0,18})$`)
+)
+
+// Ref names one version of a secret.
+type Ref struct {
+	Name string
+
+	// Version is a version number or LatestVersion.
+	Version string
+}
+
+// Validate checks the secret name and version. Resolvers rely on it to keep
+// references from escaping their namespace.
+func (r Ref) Validate() error {
+	if !namePattern.MatchString(r.Name) {
+		return fmt.Errorf("secret name %q must be 1-255 letters, digits, underscores and dashes", r.Name)
+	}
+	if !versionPattern.MatchString(r.Version) {
+		return fmt.Errorf("secret version %q must be a version number or %q", r.Version, LatestVersion)
+	}
+	return nil
+}
+
+// Resolver maps secret references to what a batch provider passes to a job.
+// Implementations must never return or log a secret's value.
+type Resolver interface {
+	// Resolve returns the provider reference for ref, e.g. a Secret Manager
+	// version resource name on GCP. It fails with ErrNotFound when the
+	// resolver can tell that the version does not exist.
+	Resolve(ctx context.Context, ref Ref) (string, error)
+}
+
+// Config selects and configures a Resolver.
+type Config struct {
+	// Provider is "gcp", "file", or empty to disable secret references.
+	Provider string
+
+	// ProjectID is the GCP project holding the secrets.
+	ProjectID string
+
+	// Dir is the root directory of the file resolver.
+	Dir string
+}
+
+// NewResolver creates the resolver named by config. It returns nil when
+// secret references are disabled.
+func NewResolver(config Config) (Resolver, error) {
+	switch config.Provider {
+	case "":
+		return nil, nil
+	case "gcp":
+		if config.ProjectID == "" {
+			return nil, errors.New("project ID is required for the gcp secret resolver")
+		}
+		return NewSecretManagerResolver(config.ProjectID), nil
+	case "file":
+		if config.Dir == "" {
+			return nil, errors.New("directory is required for the file secret resolver")
+		}
+		return NewFileResolver(config.Dir), nil
+	default:
+		return nil, fmt.Errorf("unsupported secret resolver: %s", config.Provider)
+	}
+}